@@ -1,87 +1,266 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"io"
 	"log/slog" // Added for structured logging
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"jira-mcp-server/internal/config"
 	"jira-mcp-server/internal/handlers"
 	"jira-mcp-server/internal/jira"
+	"jira-mcp-server/internal/mcp"
+	"jira-mcp-server/internal/tracing"
 
 	"github.com/gorilla/mux" // Added mux import
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper" // Added viper import
+	"go.opentelemetry.io/otel"
 )
 
 func main() {
-	// Initialize structured logger
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	transport := flag.String("transport", "http", `How clients connect: "http" for the REST API, or "stdio" for MCP JSON-RPC over stdin/stdout`)
+	flag.Parse()
+	if *transport != "http" && *transport != "stdio" {
+		slog.Error("Invalid --transport: expected http or stdio", "transport", *transport)
+		os.Exit(2)
+	}
+
+	// Initialize structured logger; the level is applied once the configuration is loaded.
+	// With the stdio transport stdout carries the protocol, so logs go to stderr.
+	var logOutput io.Writer = os.Stdout
+	if *transport == "stdio" {
+		logOutput = os.Stderr
+	}
+	logLevel := new(slog.LevelVar)
+	logger := slog.New(handlers.NewRequestIDLogHandler(slog.NewJSONHandler(logOutput, &slog.HandlerOptions{Level: logLevel})))
 	slog.SetDefault(logger)
 
 	// --- Configuration Setup using Viper ---
-	viper.SetDefault("PORT", "8080")
-	viper.SetDefault("JIRA_URL", "")        // No sensible default
-	viper.SetDefault("JIRA_USER_EMAIL", "") // No sensible default
-	viper.SetDefault("JIRA_API_TOKEN", "")  // No sensible default
-
-	viper.SetConfigName("config") // Name of config file (without extension)
-	viper.SetConfigType("yaml")   // REQUIRED if the config file does not have the extension in the name
-	viper.AddConfigPath(".")      // Look for config in the working directory
-	// viper.AddConfigPath("$HOME/.appname") // Optionally look in home directory
-	// viper.AddConfigPath("/etc/appname/") // Optionally look in /etc
-
-	// Attempt to read the config file but ignore errors if it's not found
-	if err := viper.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
-			slog.Info("Config file not found, using defaults and environment variables.")
-		} else {
-			// Config file was found but another error was produced
-			slog.Error("Error reading config file", "error", err)
-			os.Exit(1)
-		}
+	cfg, err := config.Load(viper.GetViper())
+	if err != nil {
+		slog.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
 	}
+	logLevel.Set(cfg.LogLevel)
+	slog.Info("Effective configuration loaded", "config", cfg)
+	// --- End Configuration Setup ---
 
-	viper.SetEnvPrefix("JIRA_MCP") // Env vars will be JIRA_MCP_PORT, JIRA_MCP_JIRA_URL, etc.
-	viper.AutomaticEnv()           // Read in environment variables that match
-
-	// Verify required configuration values are present (after loading defaults, file, env)
-	requiredKeys := []string{"JIRA_URL", "JIRA_USER_EMAIL", "JIRA_API_TOKEN"}
-	for _, key := range requiredKeys {
-		// Viper keys are case-insensitive, but we use uppercase for consistency
-		if viper.GetString(key) == "" {
-			// Construct the expected env var name for the error message
-			envVarName := viper.GetEnvPrefix() + "_" + key
-			slog.Error("Required configuration value not set. Set it via config file or environment variable.", "key", key, "env_var", envVarName)
-			os.Exit(1)
+	// Tracing is a no-op unless an OTLP endpoint is configured. Buffered spans are
+	// flushed once the transport has stopped, see flushTraces below.
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.OTelEndpoint)
+	if err != nil {
+		slog.Error("Failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+	flushTraces := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Failed to flush traces", "error", err)
 		}
 	}
-	// --- End Configuration Setup ---
+
+	// SIGINT and SIGTERM stop the transport gracefully instead of killing the process
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Metrics are registered on a dedicated registry rather than the global one
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	httpMetrics := handlers.NewMetrics()
+	registry.MustRegister(httpMetrics)
+	jiraCalls := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "jira_mcp_jira_calls_total",
+		Help: "Calls made to JIRA, by operation and response status class.",
+	}, []string{"operation", "status_class"})
+	registry.MustRegister(jiraCalls)
+	issueCacheLookups := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "jira_mcp_issue_cache_lookups_total",
+		Help: "Issue cache lookups, by result (hit or miss). Only counted while JIRA_MCP_ISSUE_CACHE_TTL is set.",
+	}, []string{"result"})
+	registry.MustRegister(issueCacheLookups)
 
 	// Initialize JIRA client
-	jiraClient, err := jira.NewClient(nil) // Pass nil to use http.DefaultClient
+	jiraConfig := cfg.JiraConfig()
+	jiraConfig.Logger = logger
+	jiraConfig.CallObserver = func(operation, statusClass string) {
+		jiraCalls.WithLabelValues(operation, statusClass).Inc()
+	}
+	jiraConfig.IssueCacheObserver = func(hit bool) {
+		if hit {
+			issueCacheLookups.WithLabelValues("hit").Inc()
+		} else {
+			issueCacheLookups.WithLabelValues("miss").Inc()
+		}
+	}
+	jiraClient, err := jira.NewClient(jiraConfig, nil) // Pass nil to use http.DefaultClient
 	if err != nil {
 		slog.Error("Failed to create JIRA client", "error", err)
 		os.Exit(1)
 	}
 
-	// Initialize handlers with dependencies
-	jiraHandlers := handlers.NewJiraHandlers(jiraClient, logger) // Pass logger
+	if *transport == "stdio" {
+		slog.Info("Starting JIRA MCP server on stdio")
+		server := mcp.NewServer(newAPIRouter(cfg, jiraClient, logger, httpMetrics), logger)
+		// Serve blocks reading stdin, so a signal is waited for alongside it
+		served := make(chan error, 1)
+		go func() { served <- server.Serve(ctx, os.Stdin, os.Stdout) }()
+		select {
+		case err := <-served:
+			if err != nil {
+				slog.Error("MCP stdio transport failed", "error", err)
+				flushTraces()
+				os.Exit(1)
+			}
+		case <-ctx.Done():
+			slog.Info("Shutting down JIRA MCP server")
+		}
+		flushTraces()
+		return
+	}
 
-	// Set up router
-	r := mux.NewRouter()
+	// Handlers and routes are built from the configuration so they can be rebuilt on reload
+	app := handlers.NewReloadableHandler(newAPIRouter(cfg, jiraClient, logger, httpMetrics))
+	admin := &handlers.AdminHandlers{
+		APIKey: cfg.AdminAPIKey,
+		Logger: logger,
+		Reload: func() error {
+			reloaded, err := config.Load(viper.GetViper())
+			if err != nil {
+				return err
+			}
+			slog.Info("Effective configuration reloaded", "config", reloaded)
+			app.Swap(newAPIRouter(reloaded, jiraClient, logger, httpMetrics))
+			return nil
+		},
+	}
 
-	// Register handlers
-	r.HandleFunc("/create_jira_issue", jiraHandlers.CreateJiraIssueHandler).Methods("POST")
-	r.HandleFunc("/search_jira_issues", jiraHandlers.SearchIssuesHandler).Methods("POST")
-	r.HandleFunc("/jira_issue/{issueKey}", jiraHandlers.GetIssueDetailsHandler).Methods("GET")
-	r.HandleFunc("/jira_epic/{epicKey}/issues", jiraHandlers.GetIssuesInEpicHandler).Methods("GET")
+	// MCP over SSE shares the tools and router of the stdio transport. The streams
+	// are long-lived, so they are routed outside the API router's request timeouts.
+	sse := mcp.NewSSETransport(mcp.NewServer(app, logger))
+	sseLimiter := handlers.NewConnectionLimiter(cfg.MaxSSEConnections)
 
-	port := viper.GetString("PORT") // Get port from Viper (checks env: JIRA_MCP_PORT, config: port, default: 8080)
+	// Set up router
+	r := mux.NewRouter()
+	r.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{})).Methods("GET")
+	r.Handle("/sse", sseLimiter.Middleware(http.HandlerFunc(sse.StreamHandler))).Methods("GET")
+	r.HandleFunc("/messages", sse.MessageHandler).Methods("POST")
+	if cfg.AdminAPIKey != "" {
+		r.HandleFunc("/admin/reload", admin.ReloadHandler).Methods("POST")
+	}
+	r.PathPrefix("/").Handler(app)
 
-	serverAddr := ":" + port
+	serverAddr := ":" + cfg.Port
 	slog.Info("Starting JIRA MCP server", "address", serverAddr)
-	err = http.ListenAndServe(serverAddr, r) // Use mux router
-	if err != nil {
+	// The admin endpoints check their own key. CORS wraps the whole router so browser
+	// preflights, which carry no credentials, are answered before the key and method checks.
+	apiKeyAuth := handlers.NewAPIKeyAuth(cfg.ServerAPIKey, append([]string{"/admin/reload"}, cfg.ServerAPIKeyExempt...), logger)
+	cors := handlers.NewCORS(cfg.CORSOrigins)
+	server := &http.Server{Addr: serverAddr, Handler: cors.Middleware(apiKeyAuth.Middleware(r))}
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		slog.Info("Shutting down JIRA MCP server")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			// Long-lived SSE streams do not end on their own; cut them off
+			slog.Warn("Graceful shutdown timed out, closing remaining connections", "error", err)
+			_ = server.Close()
+		}
+	}()
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
 		slog.Error("Failed to start server", "error", err)
+		flushTraces()
 		os.Exit(1)
 	}
+	<-shutdownDone
+	flushTraces()
+}
+
+// serverShutdownTimeout bounds how long in-flight requests may take to finish on shutdown.
+const serverShutdownTimeout = 10 * time.Second
+
+// tracingShutdownTimeout bounds how long buffered spans may take to be exported on shutdown.
+const tracingShutdownTimeout = 5 * time.Second
+
+// newAPIRouter creates the JIRA handlers from cfg and registers their routes.
+// Only handler settings are taken from cfg; the JIRA client and metrics are shared across reloads.
+func newAPIRouter(cfg *config.Config, jiraClient jira.JiraService, logger *slog.Logger, metrics *handlers.Metrics) *mux.Router {
+	// Initialize handlers with dependencies
+	jiraHandlers := handlers.NewJiraHandlers(jiraClient, logger) // Pass logger
+	jiraHandlers.MaxDescriptionLength = cfg.MaxDescriptionLength
+	jiraHandlers.MaxFields = cfg.MaxFields
+	jiraHandlers.PrevalidateCreate = cfg.PrevalidateCreate
+	jiraHandlers.NormalizeIssueKeys = cfg.NormalizeIssueKeys
+	jiraHandlers.MaxTotalFetch = cfg.MaxTotalFetch
+	jiraHandlers.CreateIncludeMessage = cfg.CreateIncludeMessage
+	jiraHandlers.MaxJiraCallsPerRequest = cfg.MaxJiraCallsPerRequest
+	jiraHandlers.RequestTimeout = cfg.RequestTimeout
+	jiraHandlers.RouteTimeouts = cfg.RouteTimeouts
+	jiraHandlers.OmitSelf = cfg.OmitSelf
+	jiraHandlers.Capabilities = cfg.Capabilities()
+	jiraHandlers.ResponseFieldAliases = cfg.ResponseFieldAliases
+	jiraHandlers.CreateAllowedProjects = cfg.CreateAllowedProjects
+	jiraHandlers.DefaultMaxResults = cfg.DefaultMaxResults
+	jiraHandlers.SearchAPI = cfg.SearchAPI
+
+	// Set up router
+	r := mux.NewRouter()
+	r.Use(handlers.NewTracing(otel.GetTracerProvider()).Middleware)
+	r.Use(metrics.Middleware)
+	r.Use(handlers.RequestIDMiddleware)
+	r.Use(handlers.ProblemDetailsMiddleware)
+	r.Use(jiraHandlers.CallBudgetMiddleware)
+	r.Use(jiraHandlers.TimeoutMiddleware)
+
+	// Register handlers
+	r.HandleFunc("/create_jira_issue", jiraHandlers.CreateJiraIssueHandler).Methods("POST").Name("create")
+	r.HandleFunc("/search_jira_issues", jiraHandlers.SearchIssuesHandler).Methods("GET", "POST").Name("search")
+	r.HandleFunc("/jira_issue/{issueKey}", jiraHandlers.GetIssueDetailsHandler).Methods("GET").Name("get")
+	r.HandleFunc("/jira_epic/{epicKey}/issues", jiraHandlers.GetIssuesInEpicHandler).Methods("GET").Name("epic")
+	r.HandleFunc("/jira_changed", jiraHandlers.ChangedIssuesHandler).Methods("POST").Name("changed")
+	r.HandleFunc("/jira_issue/{issueKey}/transitions", jiraHandlers.GetTransitionsHandler).Methods("GET").Name("transitions")
+	r.HandleFunc("/jira_issue/{issueKey}/transitions", jiraHandlers.TransitionIssueHandler).Methods("POST").Name("transition")
+	r.HandleFunc("/jira_search/map", jiraHandlers.SearchIssuesMapHandler).Methods("POST").Name("search_map")
+	r.HandleFunc("/jira_issue/{issueKey}/comments", jiraHandlers.AddCommentHandler).Methods("POST").Name("comment")
+	r.HandleFunc("/jira_rank", jiraHandlers.RankIssuesHandler).Methods("PUT").Name("rank")
+	r.HandleFunc("/jira_permissions", jiraHandlers.GetPermissionsHandler).Methods("GET").Name("permissions")
+	r.HandleFunc("/jira_triage", jiraHandlers.TriageHandler).Methods("GET").Name("triage")
+	r.HandleFunc("/jira_issue/{parentKey}/subtasks", jiraHandlers.CreateSubtaskHandler).Methods("POST").Name("subtask")
+	r.HandleFunc("/jira_batch", jiraHandlers.BatchHandler).Methods("POST").Name("batch")
+	r.HandleFunc("/jira_filters", jiraHandlers.GetFiltersHandler).Methods("GET").Name("filters")
+	r.HandleFunc("/jira_filter/{filterID}/issues", jiraHandlers.FilterIssuesHandler).Methods("GET").Name("filter_issues")
+	r.HandleFunc("/capabilities", jiraHandlers.CapabilitiesHandler).Methods("GET").Name("capabilities")
+	r.HandleFunc("/jira_issue/{issueKey}/attachments/from_url", jiraHandlers.AddAttachmentFromURLHandler).Methods("POST").Name("attachment_from_url")
+	r.HandleFunc("/jira_issue/{issueKey}", jiraHandlers.UpdateJiraIssueHandler).Methods("PUT").Name("update")
+	r.HandleFunc("/jira_issue/{issueKey}/exists", jiraHandlers.IssueExistsHandler).Methods("GET").Name("exists")
+	r.HandleFunc("/jira_issue/{issueKey}", jiraHandlers.DeleteJiraIssueHandler).Methods("DELETE").Name("delete")
+	r.HandleFunc("/jira_issue/{issueKey}/assignee", jiraHandlers.AssignIssueHandler).Methods("PUT").Name("assign")
+	r.HandleFunc("/jira_issues/batch", jiraHandlers.BulkGetIssuesHandler).Methods("POST").Name("bulk_get")
+	r.HandleFunc("/healthz", jiraHandlers.HealthHandler).Methods("GET").Name("healthz")
+	r.HandleFunc("/jira_projects", jiraHandlers.GetProjectsHandler).Methods("GET").Name("projects")
+	r.HandleFunc("/jira_project/{projectKey}/issuetypes", jiraHandlers.GetIssueTypesHandler).Methods("GET").Name("issuetypes")
+	r.HandleFunc("/tools", mcp.ToolsHandler).Methods("GET").Name("tools")
+	r.HandleFunc("/jira_issue_link", jiraHandlers.LinkIssuesHandler).Methods("POST").Name("issue_link")
+	r.HandleFunc("/jira_issue_link_types", jiraHandlers.GetLinkTypesHandler).Methods("GET").Name("link_types")
+	r.HandleFunc("/jira_myself", jiraHandlers.GetCurrentUserHandler).Methods("GET").Name("myself")
+	r.HandleFunc("/jira_issue/{issueKey}/changelog", jiraHandlers.GetChangelogHandler).Methods("GET").Name("changelog")
+	r.HandleFunc("/jira_issue/{issueKey}/labels", jiraHandlers.UpdateLabelsHandler).Methods("POST").Name("labels")
+	r.HandleFunc("/jira_issue/{issueKey}/watchers", jiraHandlers.GetWatchersHandler).Methods("GET").Name("watchers")
+	r.HandleFunc("/jira_issue/{issueKey}/watchers", jiraHandlers.AddWatcherHandler).Methods("POST").Name("add_watcher")
+	r.HandleFunc("/jira_issue/{issueKey}/watchers", jiraHandlers.RemoveWatcherHandler).Methods("DELETE").Name("remove_watcher")
+	r.HandleFunc("/jira_project/{projectKey}/createmeta", jiraHandlers.GetCreateMetaHandler).Methods("GET").Name("createmeta")
+
+	return r
 }
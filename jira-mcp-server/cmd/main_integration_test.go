@@ -16,6 +16,7 @@ import (
 
 	"jira-mcp-server/internal/handlers"
 	"jira-mcp-server/internal/jira"
+	"jira-mcp-server/internal/mcp"
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
@@ -33,16 +34,16 @@ func setupTestServer(t *testing.T) (*httptest.Server, *httptest.Server, func())
 		fmt.Fprintf(w, `{"error": "Mock JIRA endpoint not implemented: %s %s"}`, r.Method, r.URL.Path)
 	}))
 
-	// Set environment variables for the MCP server to use the mock JIRA
-	t.Setenv("JIRA_URL", mockJira.URL)
-	t.Setenv("JIRA_USER_EMAIL", "test-user@example.com")
-	t.Setenv("JIRA_API_TOKEN", "test-token")
 	// Ensure PORT is not set, so httptest can assign a random one
 	os.Unsetenv("PORT") // Use t.Setenv if needing to restore later, but unset is fine here
 
-	// Initialize JIRA client (will use the overridden JIRA_URL)
+	// Initialize JIRA client pointing at the mock JIRA
 	// Pass the mock server's client to ensure requests go to the mock
-	jiraClient, err := jira.NewClient(mockJira.Client())
+	jiraClient, err := jira.NewClient(jira.Config{
+		BaseURL:   mockJira.URL,
+		UserEmail: "test-user@example.com",
+		APIToken:  "test-token",
+	}, mockJira.Client())
 	require.NoError(t, err, "Failed to create JIRA client for test")
 
 	// Initialize handlers
@@ -51,10 +52,46 @@ func setupTestServer(t *testing.T) (*httptest.Server, *httptest.Server, func())
 
 	// Set up router (mirroring main.go)
 	router := mux.NewRouter()
-	router.HandleFunc("/create_jira_issue", jiraHandlers.CreateJiraIssueHandler).Methods("POST")
-	router.HandleFunc("/search_jira_issues", jiraHandlers.SearchIssuesHandler).Methods("POST")
-	router.HandleFunc("/jira_issue/{issueKey}", jiraHandlers.GetIssueDetailsHandler).Methods("GET")
-	router.HandleFunc("/jira_epic/{epicKey}/issues", jiraHandlers.GetIssuesInEpicHandler).Methods("GET")
+	router.Use(handlers.RequestIDMiddleware)
+	router.Use(handlers.ProblemDetailsMiddleware)
+	router.Use(jiraHandlers.CallBudgetMiddleware)
+	router.Use(jiraHandlers.TimeoutMiddleware)
+	router.HandleFunc("/create_jira_issue", jiraHandlers.CreateJiraIssueHandler).Methods("POST").Name("create")
+	router.HandleFunc("/search_jira_issues", jiraHandlers.SearchIssuesHandler).Methods("GET", "POST").Name("search")
+	router.HandleFunc("/jira_issue/{issueKey}", jiraHandlers.GetIssueDetailsHandler).Methods("GET").Name("get")
+	router.HandleFunc("/jira_epic/{epicKey}/issues", jiraHandlers.GetIssuesInEpicHandler).Methods("GET").Name("epic")
+	router.HandleFunc("/jira_changed", jiraHandlers.ChangedIssuesHandler).Methods("POST").Name("changed")
+	router.HandleFunc("/jira_issue/{issueKey}/transitions", jiraHandlers.GetTransitionsHandler).Methods("GET").Name("transitions")
+	router.HandleFunc("/jira_issue/{issueKey}/transitions", jiraHandlers.TransitionIssueHandler).Methods("POST").Name("transition")
+	router.HandleFunc("/jira_search/map", jiraHandlers.SearchIssuesMapHandler).Methods("POST").Name("search_map")
+	router.HandleFunc("/jira_issue/{issueKey}/comments", jiraHandlers.AddCommentHandler).Methods("POST").Name("comment")
+	router.HandleFunc("/jira_rank", jiraHandlers.RankIssuesHandler).Methods("PUT").Name("rank")
+	router.HandleFunc("/jira_permissions", jiraHandlers.GetPermissionsHandler).Methods("GET").Name("permissions")
+	router.HandleFunc("/jira_triage", jiraHandlers.TriageHandler).Methods("GET").Name("triage")
+	router.HandleFunc("/jira_issue/{parentKey}/subtasks", jiraHandlers.CreateSubtaskHandler).Methods("POST").Name("subtask")
+	router.HandleFunc("/jira_batch", jiraHandlers.BatchHandler).Methods("POST").Name("batch")
+	router.HandleFunc("/jira_filters", jiraHandlers.GetFiltersHandler).Methods("GET").Name("filters")
+	router.HandleFunc("/jira_filter/{filterID}/issues", jiraHandlers.FilterIssuesHandler).Methods("GET").Name("filter_issues")
+	router.HandleFunc("/capabilities", jiraHandlers.CapabilitiesHandler).Methods("GET").Name("capabilities")
+	router.HandleFunc("/jira_issue/{issueKey}/attachments/from_url", jiraHandlers.AddAttachmentFromURLHandler).Methods("POST").Name("attachment_from_url")
+	router.HandleFunc("/jira_issue/{issueKey}", jiraHandlers.UpdateJiraIssueHandler).Methods("PUT").Name("update")
+	router.HandleFunc("/jira_issue/{issueKey}/exists", jiraHandlers.IssueExistsHandler).Methods("GET").Name("exists")
+	router.HandleFunc("/jira_issue/{issueKey}", jiraHandlers.DeleteJiraIssueHandler).Methods("DELETE").Name("delete")
+	router.HandleFunc("/jira_issue/{issueKey}/assignee", jiraHandlers.AssignIssueHandler).Methods("PUT").Name("assign")
+	router.HandleFunc("/jira_issues/batch", jiraHandlers.BulkGetIssuesHandler).Methods("POST").Name("bulk_get")
+	router.HandleFunc("/healthz", jiraHandlers.HealthHandler).Methods("GET").Name("healthz")
+	router.HandleFunc("/jira_projects", jiraHandlers.GetProjectsHandler).Methods("GET").Name("projects")
+	router.HandleFunc("/jira_project/{projectKey}/issuetypes", jiraHandlers.GetIssueTypesHandler).Methods("GET").Name("issuetypes")
+	router.HandleFunc("/tools", mcp.ToolsHandler).Methods("GET").Name("tools")
+	router.HandleFunc("/jira_issue_link", jiraHandlers.LinkIssuesHandler).Methods("POST").Name("issue_link")
+	router.HandleFunc("/jira_issue_link_types", jiraHandlers.GetLinkTypesHandler).Methods("GET").Name("link_types")
+	router.HandleFunc("/jira_myself", jiraHandlers.GetCurrentUserHandler).Methods("GET").Name("myself")
+	router.HandleFunc("/jira_issue/{issueKey}/changelog", jiraHandlers.GetChangelogHandler).Methods("GET").Name("changelog")
+	router.HandleFunc("/jira_issue/{issueKey}/labels", jiraHandlers.UpdateLabelsHandler).Methods("POST").Name("labels")
+	router.HandleFunc("/jira_issue/{issueKey}/watchers", jiraHandlers.GetWatchersHandler).Methods("GET").Name("watchers")
+	router.HandleFunc("/jira_issue/{issueKey}/watchers", jiraHandlers.AddWatcherHandler).Methods("POST").Name("add_watcher")
+	router.HandleFunc("/jira_issue/{issueKey}/watchers", jiraHandlers.RemoveWatcherHandler).Methods("DELETE").Name("remove_watcher")
+	router.HandleFunc("/jira_project/{projectKey}/createmeta", jiraHandlers.GetCreateMetaHandler).Methods("GET").Name("createmeta")
 
 	// MCP Server using the router and configured client
 	mcpServer := httptest.NewServer(router)
@@ -183,7 +220,100 @@ func TestIntegrationCreateIssue(t *testing.T) {
 		respBodyBytes, err := io.ReadAll(resp.Body)
 		require.NoError(t, err)
 		// Check for the specific user-friendly JSON error message
-		require.JSONEq(t, `{"error":"Invalid request data sent to JIRA."}`, string(respBodyBytes))
+		require.JSONEq(t, `{"error":"Invalid request data sent to JIRA: Project key 'INVALID' does not exist."}`, string(respBodyBytes))
+	})
+
+	// --- Error Case (JIRA rejects a field): field errors are echoed back ---
+	t.Run("JiraFieldErrors", func(t *testing.T) {
+		mockJira.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/issue" {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintln(w, `{"errorMessages": [], "errors": {"customfield_10020": "Team is required.", "priority": "Priority name 'Urgent' is not valid"}}`)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		req, err := http.NewRequest("POST", mcpServer.URL+"/create_jira_issue", strings.NewReader(
+			`{"project_key": "PROJ", "summary": "Missing Team", "issue_type": "Bug"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := mcpServer.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		respBodyBytes, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"error": "Invalid request data sent to JIRA.",
+			"field_errors": {"customfield_10020": "Team is required.", "priority": "Priority name 'Urgent' is not valid"}
+		}`, string(respBodyBytes))
+	})
+
+	// --- Assignee given by email: resolved via user search, then assigned ---
+	t.Run("AssigneeEmailResolved", func(t *testing.T) {
+		var calls []string
+		mockJira.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/user/search":
+				assert.Equal(t, "jane@example.com", r.URL.Query().Get("query"))
+				fmt.Fprintln(w, `[{"accountId": "abc-1", "displayName": "Jane Doe", "emailAddress": "jane@example.com", "active": true}]`)
+			case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/createmeta/PROJ/issuetypes":
+				fmt.Fprintln(w, `{"startAt": 0, "total": 1, "issueTypes": [{"id": "10001", "name": "Task"}]}`)
+			case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/issue":
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintln(w, `{"id": "10002", "key": "TEST-2", "self": "http://mock-jira/rest/api/3/issue/10002"}`)
+			case r.Method == http.MethodPut && r.URL.Path == "/rest/api/3/issue/TEST-2/assignee":
+				bodyBytes, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"accountId": "abc-1"}`, string(bodyBytes))
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		req, err := http.NewRequest("POST", mcpServer.URL+"/create_jira_issue", strings.NewReader(
+			`{"project_key": "PROJ", "summary": "Assigned", "issue_type": "Task", "assignee_email": "jane@example.com"}`))
+		require.NoError(t, err)
+
+		resp, err := mcpServer.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		respBodyBytes, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.NotContains(t, string(respBodyBytes), "warning")
+		assert.Equal(t, []string{"GET /rest/api/3/user/search", "GET /rest/api/3/issue/createmeta/PROJ/issuetypes", "POST /rest/api/3/issue", "PUT /rest/api/3/issue/TEST-2/assignee"}, calls)
+	})
+
+	// --- Unknown assignee email: rejected before anything is created ---
+	t.Run("AssigneeEmailNotFound", func(t *testing.T) {
+		mockJira.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/user/search" {
+				fmt.Fprintln(w, `[]`)
+				return
+			}
+			t.Errorf("unexpected JIRA request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		req, err := http.NewRequest("POST", mcpServer.URL+"/create_jira_issue", strings.NewReader(
+			`{"project_key": "PROJ", "summary": "Assigned", "issue_type": "Task", "assignee_email": "nobody@example.com"}`))
+		require.NoError(t, err)
+
+		resp, err := mcpServer.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		respBodyBytes, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"error":"No JIRA user found for email nobody@example.com."}`, string(respBodyBytes))
 	})
 
 	// --- Error Case (Bad MCP Request Body) ---
@@ -326,6 +456,56 @@ func TestIntegrationGetIssue(t *testing.T) {
 		require.JSONEq(t, `{"error":"JIRA resource not found."}`, string(respBodyBytes))
 	})
 }
+func TestIntegrationUpdateIssue(t *testing.T) {
+	mcpServer, mockJira, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	t.Run("Success", func(t *testing.T) {
+		var gotBody []byte
+		mockJira.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Logf("Mock JIRA received request: %s %s", r.Method, r.URL.Path)
+			if r.Method == http.MethodPut && r.URL.Path == "/rest/api/3/issue/TEST-123" {
+				gotBody, _ = io.ReadAll(r.Body)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		req, err := http.NewRequest("PUT", mcpServer.URL+"/jira_issue/TEST-123", strings.NewReader(`{"summary":"Renamed"}`))
+		require.NoError(t, err)
+
+		resp, err := mcpServer.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		respBodyBytes, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"message":"JIRA issue updated successfully"}`, string(respBodyBytes))
+		assert.JSONEq(t, `{"fields":{"summary":"Renamed"}}`, string(gotBody), "Only the fields present should be sent")
+	})
+
+	t.Run("JiraNotFound", func(t *testing.T) {
+		mockJira.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"errorMessages": ["Issue does not exist or you do not have permission to see it."], "errors": {}}`)
+		})
+
+		req, err := http.NewRequest("PUT", mcpServer.URL+"/jira_issue/NONEXIST-1", strings.NewReader(`{"summary":"Renamed"}`))
+		require.NoError(t, err)
+
+		resp, err := mcpServer.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		respBodyBytes, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"error":"JIRA resource not found."}`, string(respBodyBytes))
+	})
+}
+
 func TestIntegrationSearchIssues(t *testing.T) {
 	mcpServer, mockJira, cleanup := setupTestServer(t)
 	defer cleanup()
@@ -439,7 +619,7 @@ func TestIntegrationSearchIssues(t *testing.T) {
 		respBodyBytes, err := io.ReadAll(resp.Body)
 		require.NoError(t, err)
 		// Check for the specific user-friendly JSON error message
-		require.JSONEq(t, `{"error":"Invalid request data sent to JIRA."}`, string(respBodyBytes))
+		require.JSONEq(t, `{"error":"Invalid request data sent to JIRA: Error in the JQL Query: The character '%' is not valid."}`, string(respBodyBytes))
 	})
 
 	// --- Error Case (Bad MCP Request Body) ---
@@ -464,6 +644,53 @@ func TestIntegrationSearchIssues(t *testing.T) {
 		// Check for the specific user-friendly JSON error message for bad decoding
 		require.JSONEq(t, `{"error":"Invalid request body"}`, string(respBodyBytes))
 	})
+
+	// --- History Predicates (WAS, CHANGED) ---
+	t.Run("HistoryPredicatesForwardedVerbatim", func(t *testing.T) {
+		const historyJQL = `status CHANGED FROM "In Progress" TO "Done" AFTER -1w AND assignee WAS currentUser() DURING ("2024/01/01", "2024/01/31")`
+
+		testCases := []struct {
+			name        string
+			body        map[string]interface{}
+			expectedJQL string
+		}{
+			{
+				name:        "raw",
+				body:        map[string]interface{}{"jql": historyJQL},
+				expectedJQL: historyJQL,
+			},
+			{
+				name:        "with default ordering",
+				body:        map[string]interface{}{"jql": historyJQL, "sort_by": "updated", "sort_dir": "desc"},
+				expectedJQL: historyJQL + " ORDER BY updated DESC",
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				mockJira.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var reqBody struct {
+						JQL string `json:"jql"`
+					}
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+					assert.Equal(t, tc.expectedJQL, reqBody.JQL, "JQL should reach JIRA unmodified")
+
+					w.Header().Set("Content-Type", "application/json")
+					fmt.Fprintln(w, `{"startAt": 0, "maxResults": 50, "total": 0, "issues": []}`)
+				})
+
+				reqBytes, _ := json.Marshal(tc.body)
+				req, err := http.NewRequest("POST", mcpServer.URL+"/search_jira_issues", bytes.NewBuffer(reqBytes))
+				require.NoError(t, err)
+				req.Header.Set("Content-Type", "application/json")
+
+				resp, err := mcpServer.Client().Do(req)
+				require.NoError(t, err)
+				defer resp.Body.Close()
+
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+			})
+		}
+	})
 }
 func TestIntegrationGetEpicIssues(t *testing.T) {
 	mcpServer, mockJira, cleanup := setupTestServer(t)
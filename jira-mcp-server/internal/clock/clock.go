@@ -0,0 +1,91 @@
+// Package clock provides an injectable source of time so that time-dependent
+// behavior (cache expiry, retry backoff, rate limiting) can be tested deterministically.
+package clock
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock abstracts the current time and sleeping.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+
+	// SleepContext is like Sleep but returns ctx's error as soon as ctx is done.
+	SleepContext(ctx context.Context, d time.Duration) error
+}
+
+// Real is a Clock backed by the standard library's time package.
+type Real struct{}
+
+// Now returns the current local time.
+func (Real) Now() time.Time { return time.Now() }
+
+// Sleep pauses the current goroutine for at least d.
+func (Real) Sleep(d time.Duration) { time.Sleep(d) }
+
+// SleepContext pauses the current goroutine for at least d, or until ctx is done.
+func (Real) SleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Fake is a manually controlled Clock for tests. Sleep advances the fake time
+// immediately instead of blocking. It is safe for concurrent use.
+type Fake struct {
+	mu    sync.Mutex
+	now   time.Time
+	slept []time.Duration
+}
+
+// NewFake returns a Fake clock set to now.
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the fake current time.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Sleep records d and advances the fake time by it without blocking.
+func (f *Fake) Sleep(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.slept = append(f.slept, d)
+	f.now = f.now.Add(d)
+}
+
+// SleepContext returns ctx's error without advancing the fake time if ctx is
+// already done, and otherwise behaves like Sleep.
+func (f *Fake) SleepContext(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.Sleep(d)
+	return nil
+}
+
+// Advance moves the fake time forward by d.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// Slept returns the durations passed to Sleep, in call order.
+func (f *Fake) Slept() []time.Duration {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]time.Duration(nil), f.slept...)
+}
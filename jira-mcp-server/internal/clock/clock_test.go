@@ -0,0 +1,48 @@
+package clock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"jira-mcp-server/internal/clock"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+
+	assert.Equal(t, start, fake.Now())
+
+	fake.Advance(time.Minute)
+	assert.Equal(t, start.Add(time.Minute), fake.Now())
+
+	fake.Sleep(2 * time.Second)
+	assert.Equal(t, start.Add(time.Minute+2*time.Second), fake.Now(), "Sleep should advance the fake time")
+	assert.Equal(t, []time.Duration{2 * time.Second}, fake.Slept())
+}
+
+func TestFake_SleepContext(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+
+	assert.NoError(t, fake.SleepContext(context.Background(), time.Second))
+	assert.Equal(t, start.Add(time.Second), fake.Now())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, fake.SleepContext(ctx, time.Second), context.Canceled)
+	assert.Equal(t, start.Add(time.Second), fake.Now(), "A cancelled sleep should not advance the fake time")
+	assert.Equal(t, []time.Duration{time.Second}, fake.Slept())
+}
+
+func TestReal_SleepContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	started := time.Now()
+	assert.ErrorIs(t, clock.Real{}.SleepContext(ctx, time.Minute), context.Canceled)
+	assert.Less(t, time.Since(started), 5*time.Second)
+}
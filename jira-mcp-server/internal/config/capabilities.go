@@ -0,0 +1,75 @@
+package config
+
+// JiraAPIVersion is the JIRA REST API version the client talks to.
+const JiraAPIVersion = "3"
+
+// Capabilities describes the optional features and key limits of the running
+// server, as returned by GET /capabilities. It never contains secrets.
+type Capabilities struct {
+	JiraAPIVersion string `json:"jira_api_version"`
+
+	Features CapabilityFeatures `json:"features"`
+	Limits   CapabilityLimits   `json:"limits"`
+
+	RequestTimeout string            `json:"request_timeout"`
+	RouteTimeouts  map[string]string `json:"route_timeouts"`
+
+	// DefaultMaxResults is the page size used per route when maxResults is omitted.
+	DefaultMaxResults map[string]int `json:"default_max_results"`
+
+	// SearchAPI is "search" if searches page with startAt, or "jql" if they page
+	// with next_page_token.
+	SearchAPI string `json:"search_api"`
+}
+
+// CapabilityFeatures reports which optional behaviours are enabled.
+type CapabilityFeatures struct {
+	CreateIssues         bool `json:"create_issues"`
+	PrevalidateCreate    bool `json:"prevalidate_create"`
+	NormalizeIssueKeys   bool `json:"normalize_issue_keys"`
+	CreateIncludeMessage bool `json:"create_include_message"`
+	OmitSelf             bool `json:"response_omit_self"`
+	AdminReload          bool `json:"admin_reload"`
+	RateLimited          bool `json:"rate_limited"`
+	AttachFromURL        bool `json:"attach_from_url"`
+}
+
+// CapabilityLimits reports the configured request limits. Zero means unlimited.
+type CapabilityLimits struct {
+	MaxDescriptionLength   int `json:"max_description_length"`
+	MaxFields              int `json:"max_fields"`
+	MaxTotalFetch          int `json:"max_total_fetch"`
+	MaxJiraCallsPerRequest int `json:"max_jira_calls_per_request"`
+}
+
+// Capabilities derives the capabilities advertised to clients from the configuration.
+func (c *Config) Capabilities() Capabilities {
+	routeTimeouts := make(map[string]string, len(c.RouteTimeouts))
+	for route, d := range c.RouteTimeouts {
+		routeTimeouts[route] = d.String()
+	}
+
+	return Capabilities{
+		JiraAPIVersion: JiraAPIVersion,
+		Features: CapabilityFeatures{
+			CreateIssues:         true,
+			PrevalidateCreate:    c.PrevalidateCreate,
+			NormalizeIssueKeys:   c.NormalizeIssueKeys,
+			CreateIncludeMessage: c.CreateIncludeMessage,
+			OmitSelf:             c.OmitSelf,
+			AdminReload:          c.AdminAPIKey != "",
+			RateLimited:          c.rateLimiter() != nil,
+			AttachFromURL:        len(c.AttachmentURLHosts) > 0,
+		},
+		DefaultMaxResults: c.DefaultMaxResults,
+		Limits: CapabilityLimits{
+			MaxDescriptionLength:   c.MaxDescriptionLength,
+			MaxFields:              c.MaxFields,
+			MaxTotalFetch:          c.MaxTotalFetch,
+			MaxJiraCallsPerRequest: c.MaxJiraCallsPerRequest,
+		},
+		RequestTimeout: c.RequestTimeout.String(),
+		RouteTimeouts:  routeTimeouts,
+		SearchAPI:      c.SearchAPI,
+	}
+}
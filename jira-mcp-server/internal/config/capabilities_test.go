@@ -0,0 +1,45 @@
+package config_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/config"
+)
+
+func TestConfig_Capabilities(t *testing.T) {
+	cfg := &config.Config{
+		APIToken:       "super-secret-token",
+		AdminAPIKey:    "admin-secret-key",
+		MaxFields:      100,
+		RequestTimeout: 30 * time.Second,
+		RouteTimeouts:  map[string]time.Duration{"search": time.Minute},
+		SearchAPI:      "jql",
+	}
+
+	caps := cfg.Capabilities()
+	assert.Equal(t, "3", caps.JiraAPIVersion)
+	assert.False(t, caps.Features.PrevalidateCreate)
+	assert.False(t, caps.Features.RateLimited)
+	assert.True(t, caps.Features.AdminReload)
+	assert.Equal(t, map[string]string{"search": "1m0s"}, caps.RouteTimeouts)
+	assert.Equal(t, "jql", caps.SearchAPI)
+
+	cfg.PrevalidateCreate = true
+	cfg.RateLimitPerSecond = 5
+	cfg.MaxFields = 20
+
+	caps = cfg.Capabilities()
+	assert.True(t, caps.Features.PrevalidateCreate)
+	assert.True(t, caps.Features.RateLimited)
+	assert.Equal(t, 20, caps.Limits.MaxFields)
+
+	body, err := json.Marshal(caps)
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "super-secret-token")
+	assert.NotContains(t, string(body), "admin-secret-key")
+}
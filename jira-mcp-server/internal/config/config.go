@@ -0,0 +1,428 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"jira-mcp-server/internal/jira"
+
+	"github.com/spf13/viper"
+)
+
+// EnvPrefix is the prefix for environment variables read by Viper,
+// e.g. JIRA_MCP_PORT, JIRA_MCP_JIRA_URL.
+const EnvPrefix = "JIRA_MCP"
+
+// defaultMaxResultsKeys maps each *_DEFAULT_MAX setting to the route (mux route
+// name) whose default page size it sets when a request omits maxResults.
+var defaultMaxResultsKeys = map[string]string{
+	"SEARCH_DEFAULT_MAX":  "search",
+	"EPIC_DEFAULT_MAX":    "epic",
+	"CHANGED_DEFAULT_MAX": "changed",
+	"TRIAGE_DEFAULT_MAX":  "triage",
+	"FILTER_DEFAULT_MAX":  "filter_issues",
+}
+
+// DefaultMaxResults is the built-in default page size of every endpoint.
+const DefaultMaxResults = 50
+
+// credentialKeys are the configuration keys required to talk to JIRA.
+// JIRA_USER_EMAIL is not needed with the bearer auth mode.
+var credentialKeys = []string{"JIRA_URL", "JIRA_USER_EMAIL", "JIRA_API_TOKEN"}
+
+// redacted replaces secret values in log output.
+const redacted = "[REDACTED]"
+
+// Config holds the effective server configuration after merging defaults,
+// the optional config file, and environment variables.
+type Config struct {
+	Port                   string
+	JiraURL                string
+	UserEmail              string
+	APIToken               string
+	MaxDescriptionLength   int
+	MaxFields              int
+	PrevalidateCreate      bool
+	NormalizeIssueKeys     bool
+	MaxTotalFetch          int
+	SprintFieldID          string
+	CreateIncludeMessage   bool
+	AdminAPIKey            string
+	MaxJiraCallsPerRequest int
+	RequestTimeout         time.Duration
+	RouteTimeouts          map[string]time.Duration
+	DefaultMaxResults      map[string]int // Keyed by route name
+	OmitSelf               bool
+	RateLimitPerSecond     float64
+	RateLimitBurst         int
+	RateLimitHosts         map[string]float64
+	LogLevel               slog.Level
+	LogMaskFields          []string
+	AttachmentURLHosts     []string
+	AttachmentMaxBytes     int64
+	AttachmentFetchTimeout time.Duration
+	ResponseFieldAliases   map[string]string
+	EpicLinkFieldID        string
+	EpicLinkOnCreate       bool
+	CreateAllowedProjects  []string
+	MaxRetries             int
+	JiraCallTimeout        time.Duration
+	MaxSSEConnections      int
+	AuthMode               jira.AuthMode
+	APIVersion             int
+	SearchAPI              string
+	CORSOrigins            []string
+	ServerAPIKey           string
+	ServerAPIKeyExempt     []string
+	IssueCacheTTL          time.Duration
+	OTelEndpoint           string
+
+	// ConfigFile is the path of the config file that was read, or empty if none was found.
+	ConfigFile string
+}
+
+// Load reads the configuration into v from defaults, an optional config.yaml in
+// the working directory, and JIRA_MCP_-prefixed environment variables (highest precedence).
+// It returns an error if the config file is malformed or a required value is missing.
+func Load(v *viper.Viper) (*Config, error) {
+	v.SetDefault("PORT", "8080")
+	v.SetDefault("JIRA_URL", "")                  // No sensible default
+	v.SetDefault("JIRA_USER_EMAIL", "")           // No sensible default
+	v.SetDefault("JIRA_API_TOKEN", "")            // No sensible default
+	v.SetDefault("MAX_DESCRIPTION_LENGTH", 32767) // JIRA's limit for multi-line text fields
+	v.SetDefault("MAX_FIELDS", 100)
+	v.SetDefault("PREVALIDATE_CREATE", false)
+	v.SetDefault("NORMALIZE_ISSUE_KEYS", true)
+	v.SetDefault("MAX_TOTAL_FETCH", 1000)
+	v.SetDefault("SPRINT_FIELD_ID", jira.DefaultSprintFieldName)
+	v.SetDefault("CREATE_INCLUDE_MESSAGE", true)
+	v.SetDefault("MAX_JIRA_CALLS_PER_REQUEST", 50)
+	v.SetDefault("REQUEST_TIMEOUT", 30*time.Second)
+	v.SetDefault("ROUTE_TIMEOUTS", "")
+	v.SetDefault("RESPONSE_OMIT_SELF", false)
+	v.SetDefault("RATE_LIMIT_PER_SECOND", 0)
+	v.SetDefault("RATE_LIMIT_BURST", 10)
+	v.SetDefault("RATE_LIMIT_HOSTS", "")
+	v.SetDefault("LOG_LEVEL", "info")
+	v.SetDefault("LOG_MASK_FIELDS", "")
+	v.SetDefault("ATTACHMENT_URL_HOSTS", "")
+	v.SetDefault("ATTACHMENT_MAX_BYTES", jira.DefaultAttachmentMaxBytes)
+	v.SetDefault("ATTACHMENT_FETCH_TIMEOUT", jira.DefaultAttachmentFetchTimeout)
+	v.SetDefault("RESPONSE_FIELD_ALIASES", "")
+	for key := range defaultMaxResultsKeys {
+		v.SetDefault(key, DefaultMaxResults)
+	}
+	v.SetDefault("EPIC_LINK_FIELD_ID", "") // Detected from JIRA's field list
+	v.SetDefault("EPIC_LINK_ON_CREATE", false)
+	v.SetDefault("CREATE_ALLOWED_PROJECTS", "")
+	v.SetDefault("MAX_RETRIES", jira.DefaultMaxRetries)
+	v.SetDefault("JIRA_CALL_TIMEOUT", 30*time.Second)
+	v.SetDefault("MAX_SSE_CONNECTIONS", 100)
+	v.SetDefault("AUTH_MODE", string(jira.AuthBasic))
+	v.SetDefault("API_VERSION", jira.DefaultAPIVersion)
+	v.SetDefault("SEARCH_API", jira.SearchAPIOffset)
+	v.SetDefault("CORS_ORIGINS", "")
+	v.SetDefault("SERVER_API_KEY", "")
+	v.SetDefault("SERVER_API_KEY_EXEMPT", "")
+	v.SetDefault("ISSUE_CACHE_TTL", 0)
+	v.SetDefault("OTEL_ENDPOINT", "")
+
+	v.SetConfigName("config") // Name of config file (without extension)
+	v.SetConfigType("yaml")   // REQUIRED if the config file does not have the extension in the name
+	v.AddConfigPath(".")      // Look for config in the working directory
+
+	// Attempt to read the config file but ignore errors if it's not found
+	if err := v.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			// Config file was found but another error was produced
+			return nil, fmt.Errorf("error reading config file: %w", err)
+		}
+	}
+
+	v.SetEnvPrefix(EnvPrefix) // Env vars will be JIRA_MCP_PORT, JIRA_MCP_JIRA_URL, etc.
+	v.AutomaticEnv()          // Read in environment variables that match
+
+	// The JIRA credentials are also accepted without the prefix (JIRA_URL etc.) for
+	// compatibility with earlier releases; the prefixed form wins if both are set.
+	for _, key := range credentialKeys {
+		if err := v.BindEnv(key, EnvPrefix+"_"+key, key); err != nil {
+			return nil, fmt.Errorf("error binding environment variable for %s: %w", key, err)
+		}
+	}
+
+	authMode := jira.AuthMode(strings.ToLower(strings.TrimSpace(v.GetString("AUTH_MODE"))))
+	if authMode != jira.AuthBasic && authMode != jira.AuthBearer {
+		return nil, fmt.Errorf("invalid AUTH_MODE %q: expected basic or bearer", v.GetString("AUTH_MODE"))
+	}
+
+	apiVersion := v.GetInt("API_VERSION")
+	if apiVersion != 2 && apiVersion != 3 {
+		return nil, fmt.Errorf("invalid API_VERSION %q: expected 2 or 3", v.GetString("API_VERSION"))
+	}
+
+	searchAPI := strings.ToLower(strings.TrimSpace(v.GetString("SEARCH_API")))
+	if searchAPI != jira.SearchAPIOffset && searchAPI != jira.SearchAPIJQL {
+		return nil, fmt.Errorf("invalid SEARCH_API %q: expected search or jql", v.GetString("SEARCH_API"))
+	}
+	if searchAPI == jira.SearchAPIJQL && apiVersion != 3 {
+		// The token-paged search only exists in JIRA Cloud
+		return nil, fmt.Errorf("SEARCH_API jql requires API_VERSION 3")
+	}
+
+	// Verify required configuration values are present (after loading defaults, file, env)
+	for _, key := range credentialKeys {
+		if key == "JIRA_USER_EMAIL" && authMode == jira.AuthBearer {
+			continue
+		}
+		if v.GetString(key) == "" {
+			return nil, fmt.Errorf("required configuration value %s not set; set it via config file or the %s_%s environment variable", key, EnvPrefix, key)
+		}
+	}
+
+	routeTimeouts, err := ParseRouteTimeouts(v.GetString("ROUTE_TIMEOUTS"))
+	if err != nil {
+		return nil, err
+	}
+	rateLimitHosts, err := ParseRateLimitHosts(v.GetString("RATE_LIMIT_HOSTS"))
+	if err != nil {
+		return nil, err
+	}
+	responseFieldAliases, err := ParseFieldAliases(v.GetString("RESPONSE_FIELD_ALIASES"))
+	if err != nil {
+		return nil, err
+	}
+	defaultMaxResults := make(map[string]int, len(defaultMaxResultsKeys))
+	for key, route := range defaultMaxResultsKeys {
+		n := v.GetInt(key)
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid %s %q: expected a positive integer", key, v.GetString(key))
+		}
+		defaultMaxResults[route] = n
+	}
+	issueCacheTTL := v.GetDuration("ISSUE_CACHE_TTL")
+	if issueCacheTTL < 0 {
+		return nil, fmt.Errorf("invalid ISSUE_CACHE_TTL %q: expected a non-negative duration", v.GetString("ISSUE_CACHE_TTL"))
+	}
+	var logLevel slog.Level
+	if err := logLevel.UnmarshalText([]byte(v.GetString("LOG_LEVEL"))); err != nil {
+		return nil, fmt.Errorf("invalid LOG_LEVEL %q: expected debug, info, warn or error", v.GetString("LOG_LEVEL"))
+	}
+
+	return &Config{
+		Port:                   v.GetString("PORT"),
+		JiraURL:                v.GetString("JIRA_URL"),
+		UserEmail:              v.GetString("JIRA_USER_EMAIL"),
+		APIToken:               v.GetString("JIRA_API_TOKEN"),
+		MaxDescriptionLength:   v.GetInt("MAX_DESCRIPTION_LENGTH"),
+		MaxFields:              v.GetInt("MAX_FIELDS"),
+		PrevalidateCreate:      v.GetBool("PREVALIDATE_CREATE"),
+		NormalizeIssueKeys:     v.GetBool("NORMALIZE_ISSUE_KEYS"),
+		MaxTotalFetch:          v.GetInt("MAX_TOTAL_FETCH"),
+		SprintFieldID:          v.GetString("SPRINT_FIELD_ID"),
+		CreateIncludeMessage:   v.GetBool("CREATE_INCLUDE_MESSAGE"),
+		AdminAPIKey:            v.GetString("ADMIN_API_KEY"),
+		MaxJiraCallsPerRequest: v.GetInt("MAX_JIRA_CALLS_PER_REQUEST"),
+		RequestTimeout:         v.GetDuration("REQUEST_TIMEOUT"),
+		RouteTimeouts:          routeTimeouts,
+		DefaultMaxResults:      defaultMaxResults,
+		OmitSelf:               v.GetBool("RESPONSE_OMIT_SELF"),
+		RateLimitPerSecond:     v.GetFloat64("RATE_LIMIT_PER_SECOND"),
+		RateLimitBurst:         v.GetInt("RATE_LIMIT_BURST"),
+		RateLimitHosts:         rateLimitHosts,
+		LogLevel:               logLevel,
+		LogMaskFields:          splitList(v.GetString("LOG_MASK_FIELDS")),
+		AttachmentURLHosts:     splitList(v.GetString("ATTACHMENT_URL_HOSTS")),
+		AttachmentMaxBytes:     v.GetInt64("ATTACHMENT_MAX_BYTES"),
+		AttachmentFetchTimeout: v.GetDuration("ATTACHMENT_FETCH_TIMEOUT"),
+		ResponseFieldAliases:   responseFieldAliases,
+		EpicLinkFieldID:        v.GetString("EPIC_LINK_FIELD_ID"),
+		EpicLinkOnCreate:       v.GetBool("EPIC_LINK_ON_CREATE"),
+		CreateAllowedProjects:  splitList(v.GetString("CREATE_ALLOWED_PROJECTS")),
+		MaxRetries:             v.GetInt("MAX_RETRIES"),
+		JiraCallTimeout:        v.GetDuration("JIRA_CALL_TIMEOUT"),
+		MaxSSEConnections:      v.GetInt("MAX_SSE_CONNECTIONS"),
+		AuthMode:               authMode,
+		APIVersion:             apiVersion,
+		SearchAPI:              searchAPI,
+		CORSOrigins:            splitList(v.GetString("CORS_ORIGINS")),
+		ServerAPIKey:           v.GetString("SERVER_API_KEY"),
+		ServerAPIKeyExempt:     splitList(v.GetString("SERVER_API_KEY_EXEMPT")),
+		IssueCacheTTL:          issueCacheTTL,
+		OTelEndpoint:           v.GetString("OTEL_ENDPOINT"),
+		ConfigFile:             v.ConfigFileUsed(),
+	}, nil
+}
+
+// ParseRouteTimeouts parses a comma-separated list of route name to timeout
+// pairs, e.g. "search:30s,get:5s", as used by ROUTE_TIMEOUTS.
+func ParseRouteTimeouts(s string) (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid ROUTE_TIMEOUTS entry %q: expected route:duration", pair)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("invalid ROUTE_TIMEOUTS duration for route %q: %q", name, value)
+		}
+		timeouts[strings.TrimSpace(name)] = d
+	}
+	return timeouts, nil
+}
+
+// ParseRateLimitHosts parses a comma-separated list of JIRA host to requests-per-second
+// pairs, e.g. "a.atlassian.net=5,b.atlassian.net=20", as used by RATE_LIMIT_HOSTS.
+func ParseRateLimitHosts(s string) (map[string]float64, error) {
+	rates := make(map[string]float64)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		host, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(host) == "" {
+			return nil, fmt.Errorf("invalid RATE_LIMIT_HOSTS entry %q: expected host=rate", pair)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || rate < 0 {
+			return nil, fmt.Errorf("invalid RATE_LIMIT_HOSTS rate for host %q: %q", host, value)
+		}
+		rates[strings.TrimSpace(host)] = rate
+	}
+	return rates, nil
+}
+
+// ParseFieldAliases parses a comma-separated list of JIRA field to alias pairs,
+// e.g. "customfield_10020=story_points", as used by RESPONSE_FIELD_ALIASES.
+func ParseFieldAliases(s string) (map[string]string, error) {
+	aliases := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		field, alias, ok := strings.Cut(pair, "=")
+		field, alias = strings.TrimSpace(field), strings.TrimSpace(alias)
+		if !ok || field == "" || alias == "" {
+			return nil, fmt.Errorf("invalid RESPONSE_FIELD_ALIASES entry %q: expected field=alias", pair)
+		}
+		aliases[field] = alias
+	}
+	return aliases, nil
+}
+
+// splitList splits a comma-separated configuration value, dropping empty entries.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// JiraConfig returns the subset of the configuration needed to construct a jira.Client.
+func (c *Config) JiraConfig() jira.Config {
+	return jira.Config{
+		BaseURL:    c.JiraURL,
+		UserEmail:  c.UserEmail,
+		APIToken:   c.APIToken,
+		AuthMode:   c.AuthMode,
+		APIVersion: c.APIVersion,
+
+		SprintFieldID:    c.SprintFieldID,
+		EpicLinkFieldID:  c.EpicLinkFieldID,
+		EpicLinkOnCreate: c.EpicLinkOnCreate,
+		RateLimiter:      c.rateLimiter(),
+		MaxRetries:       c.MaxRetries,
+		RequestTimeout:   c.JiraCallTimeout,
+		LogMaskFields:    c.LogMaskFields,
+
+		AttachmentURLHosts:     c.AttachmentURLHosts,
+		AttachmentMaxBytes:     c.AttachmentMaxBytes,
+		AttachmentFetchTimeout: c.AttachmentFetchTimeout,
+
+		IssueCacheTTL: c.IssueCacheTTL,
+	}
+}
+
+// rateLimiter builds the JIRA host rate limiter from the RATE_LIMIT_* settings, or
+// returns nil if neither a default nor a per-host rate is configured.
+func (c *Config) rateLimiter() *jira.HostRateLimiter {
+	if c.RateLimitPerSecond <= 0 && len(c.RateLimitHosts) == 0 {
+		return nil
+	}
+	perHost := make(map[string]jira.RateLimit, len(c.RateLimitHosts))
+	for host, rate := range c.RateLimitHosts {
+		perHost[host] = jira.RateLimit{Rate: rate, Burst: c.RateLimitBurst}
+	}
+	return jira.NewHostRateLimiter(jira.RateLimit{Rate: c.RateLimitPerSecond, Burst: c.RateLimitBurst}, perHost, nil)
+}
+
+// LogValue implements slog.LogValuer so the effective configuration can be logged
+// directly. Secrets are redacted and only the host of the JIRA URL is included.
+func (c *Config) LogValue() slog.Value {
+	jiraHost := ""
+	if u, err := url.Parse(c.JiraURL); err == nil {
+		jiraHost = u.Host
+	}
+
+	configFile := c.ConfigFile
+	if configFile == "" {
+		configFile = "none"
+	}
+
+	return slog.GroupValue(
+		slog.String("port", c.Port),
+		slog.String("jira_host", jiraHost),
+		slog.String("jira_user_email", c.UserEmail),
+		slog.String("jira_api_token", redacted),
+		slog.Int("max_description_length", c.MaxDescriptionLength),
+		slog.Int("max_fields", c.MaxFields),
+		slog.Bool("prevalidate_create", c.PrevalidateCreate),
+		slog.Bool("normalize_issue_keys", c.NormalizeIssueKeys),
+		slog.Int("max_total_fetch", c.MaxTotalFetch),
+		slog.String("sprint_field_id", c.SprintFieldID),
+		slog.Bool("create_include_message", c.CreateIncludeMessage),
+		slog.Bool("admin_api_key_set", c.AdminAPIKey != ""),
+		slog.Int("max_jira_calls_per_request", c.MaxJiraCallsPerRequest),
+		slog.Duration("request_timeout", c.RequestTimeout),
+		slog.Any("route_timeouts", c.RouteTimeouts),
+		slog.Any("default_max_results", c.DefaultMaxResults),
+		slog.Bool("response_omit_self", c.OmitSelf),
+		slog.Float64("rate_limit_per_second", c.RateLimitPerSecond),
+		slog.Int("rate_limit_burst", c.RateLimitBurst),
+		slog.Any("rate_limit_hosts", c.RateLimitHosts),
+		slog.String("log_level", c.LogLevel.String()),
+		slog.Any("log_mask_fields", c.LogMaskFields),
+		slog.Any("attachment_url_hosts", c.AttachmentURLHosts),
+		slog.Int64("attachment_max_bytes", c.AttachmentMaxBytes),
+		slog.Duration("attachment_fetch_timeout", c.AttachmentFetchTimeout),
+		slog.Any("response_field_aliases", c.ResponseFieldAliases),
+		slog.String("epic_link_field_id", c.EpicLinkFieldID),
+		slog.Bool("epic_link_on_create", c.EpicLinkOnCreate),
+		slog.Any("create_allowed_projects", c.CreateAllowedProjects),
+		slog.Int("max_retries", c.MaxRetries),
+		slog.Duration("jira_call_timeout", c.JiraCallTimeout),
+		slog.Int("max_sse_connections", c.MaxSSEConnections),
+		slog.String("auth_mode", string(c.AuthMode)),
+		slog.Int("api_version", c.APIVersion),
+		slog.String("search_api", c.SearchAPI),
+		slog.Any("cors_origins", c.CORSOrigins),
+		slog.Bool("server_api_key_set", c.ServerAPIKey != ""),
+		slog.Any("server_api_key_exempt", c.ServerAPIKeyExempt),
+		slog.Duration("issue_cache_ttl", c.IssueCacheTTL),
+		slog.String("otel_endpoint", c.OTelEndpoint),
+		slog.String("config_file", configFile),
+	)
+}
@@ -0,0 +1,386 @@
+package config_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/config"
+	"jira-mcp-server/internal/jira"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("Success From Environment", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "super-secret-token")
+		t.Setenv("JIRA_MCP_PORT", "9090")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, "9090", cfg.Port)
+		assert.Equal(t, "https://example.atlassian.net", cfg.JiraURL)
+		assert.Equal(t, "bot@example.com", cfg.UserEmail)
+		assert.Equal(t, "super-secret-token", cfg.APIToken)
+		assert.Equal(t, 32767, cfg.MaxDescriptionLength, "Default should apply when unset")
+		assert.Equal(t, 30*time.Second, cfg.JiraConfig().RequestTimeout, "JIRA calls should be bounded by default")
+		assert.Empty(t, cfg.CORSOrigins, "CORS should be disabled by default")
+		assert.Empty(t, cfg.ServerAPIKey, "API key authentication should be disabled by default")
+		assert.Zero(t, cfg.IssueCacheTTL, "The issue cache should be disabled by default")
+		assert.Empty(t, cfg.OTelEndpoint, "Tracing should be disabled by default")
+	})
+
+	t.Run("CORS Origins", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_CORS_ORIGINS", "https://dash.example.com, http://localhost:3000,")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://dash.example.com", "http://localhost:3000"}, cfg.CORSOrigins)
+	})
+
+	t.Run("Server API Key", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_SERVER_API_KEY", "server-secret")
+		t.Setenv("JIRA_MCP_SERVER_API_KEY_EXEMPT", "/healthz, /metrics")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, "server-secret", cfg.ServerAPIKey)
+		assert.Equal(t, []string{"/healthz", "/metrics"}, cfg.ServerAPIKeyExempt)
+	})
+
+	t.Run("Unprefixed Credentials Accepted", func(t *testing.T) {
+		t.Setenv("JIRA_URL", "https://legacy.atlassian.net")
+		t.Setenv("JIRA_USER_EMAIL", "legacy@example.com")
+		t.Setenv("JIRA_API_TOKEN", "legacy-token")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://legacy.atlassian.net", cfg.JiraURL)
+		assert.Equal(t, "legacy@example.com", cfg.UserEmail)
+		assert.Equal(t, "legacy-token", cfg.APIToken)
+	})
+
+	t.Run("Prefixed Credentials Take Precedence", func(t *testing.T) {
+		t.Setenv("JIRA_URL", "https://legacy.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://prefixed.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://prefixed.atlassian.net", cfg.JiraURL)
+	})
+
+	t.Run("Error Missing Required Value", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "")
+
+		cfg, err := config.Load(viper.New())
+
+		require.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "JIRA_MCP_JIRA_API_TOKEN")
+	})
+
+	t.Run("Bearer Auth Without Email", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://jira.internal.example.com")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "personal-access-token")
+		t.Setenv("JIRA_MCP_AUTH_MODE", "Bearer")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, jira.AuthBearer, cfg.AuthMode)
+		_, err = jira.NewClient(cfg.JiraConfig(), nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Data Center With Context Path And API v2", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://jira.internal.example.com/jira/")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "personal-access-token")
+		t.Setenv("JIRA_MCP_AUTH_MODE", "bearer")
+		t.Setenv("JIRA_MCP_API_VERSION", "2")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, cfg.APIVersion)
+		_, err = jira.NewClient(cfg.JiraConfig(), nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error Invalid API Version", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_API_VERSION", "4")
+
+		_, err := config.Load(viper.New())
+
+		assert.ErrorContains(t, err, `invalid API_VERSION "4"`)
+	})
+
+	t.Run("Error Basic Auth Without Email", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+
+		_, err := config.Load(viper.New())
+
+		assert.ErrorContains(t, err, "JIRA_MCP_JIRA_USER_EMAIL")
+	})
+
+	t.Run("Error Invalid Auth Mode", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_AUTH_MODE", "oauth")
+
+		_, err := config.Load(viper.New())
+
+		assert.ErrorContains(t, err, `invalid AUTH_MODE "oauth"`)
+	})
+
+	t.Run("Search API", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+
+		cfg, err := config.Load(viper.New())
+		require.NoError(t, err)
+		assert.Equal(t, jira.SearchAPIOffset, cfg.SearchAPI, "The offset search should stay the default")
+
+		t.Setenv("JIRA_MCP_SEARCH_API", "JQL")
+		cfg, err = config.Load(viper.New())
+		require.NoError(t, err)
+		assert.Equal(t, jira.SearchAPIJQL, cfg.SearchAPI)
+
+		t.Setenv("JIRA_MCP_SEARCH_API", "v2")
+		_, err = config.Load(viper.New())
+		assert.ErrorContains(t, err, `invalid SEARCH_API "v2"`)
+	})
+
+	t.Run("Logging Settings", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_LOG_LEVEL", "DEBUG")
+		t.Setenv("JIRA_MCP_LOG_MASK_FIELDS", "customfield_10050, customfield_10051,")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, slog.LevelDebug, cfg.LogLevel)
+		assert.Equal(t, []string{"customfield_10050", "customfield_10051"}, cfg.JiraConfig().LogMaskFields)
+	})
+
+	t.Run("Default Max Results", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_CHANGED_DEFAULT_MAX", "10")
+		t.Setenv("JIRA_MCP_FILTER_DEFAULT_MAX", "200")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"search": 50, "epic": 50, "changed": 10, "triage": 50, "filter_issues": 200}, cfg.DefaultMaxResults)
+	})
+
+	t.Run("Error Invalid Default Max Results", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_SEARCH_DEFAULT_MAX", "0")
+
+		_, err := config.Load(viper.New())
+
+		assert.ErrorContains(t, err, `invalid SEARCH_DEFAULT_MAX "0"`)
+	})
+
+	t.Run("Error Invalid Log Level", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_LOG_LEVEL", "verbose")
+
+		_, err := config.Load(viper.New())
+
+		assert.ErrorContains(t, err, `invalid LOG_LEVEL "verbose"`)
+	})
+
+	t.Run("Issue Cache TTL", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_ISSUE_CACHE_TTL", "30s")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Second, cfg.IssueCacheTTL)
+		assert.Equal(t, 30*time.Second, cfg.JiraConfig().IssueCacheTTL)
+	})
+
+	t.Run("Error Negative Issue Cache TTL", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_ISSUE_CACHE_TTL", "-1s")
+
+		_, err := config.Load(viper.New())
+
+		assert.ErrorContains(t, err, `invalid ISSUE_CACHE_TTL "-1s"`)
+	})
+
+	t.Run("OTel Endpoint From Env", func(t *testing.T) {
+		t.Setenv("JIRA_MCP_JIRA_URL", "https://example.atlassian.net")
+		t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+		t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "token")
+		t.Setenv("JIRA_MCP_OTEL_ENDPOINT", "http://otel-collector:4318")
+
+		cfg, err := config.Load(viper.New())
+
+		require.NoError(t, err)
+		assert.Equal(t, "http://otel-collector:4318", cfg.OTelEndpoint)
+	})
+}
+
+func TestConfig_PrefixedEnvFlowsToClient(t *testing.T) {
+	var gotUser, gotToken string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser, gotToken, _ = r.BasicAuth()
+		_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{}}`))
+	}))
+	defer server.Close()
+
+	// Only the prefixed variables are set; the client must not need JIRA_URL etc.
+	t.Setenv("JIRA_MCP_JIRA_URL", server.URL)
+	t.Setenv("JIRA_MCP_JIRA_USER_EMAIL", "bot@example.com")
+	t.Setenv("JIRA_MCP_JIRA_API_TOKEN", "prefixed-token")
+
+	cfg, err := config.Load(viper.New())
+	require.NoError(t, err)
+
+	client, err := jira.NewClient(cfg.JiraConfig(), server.Client())
+	require.NoError(t, err)
+
+	issue, err := client.GetIssue(context.Background(), "TEST-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "TEST-1", issue.Key)
+	assert.Equal(t, "bot@example.com", gotUser)
+	assert.Equal(t, "prefixed-token", gotToken)
+}
+
+func TestConfig_FileCredentialsFlowToClient(t *testing.T) {
+	var gotUser, gotToken string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser, gotToken, _ = r.BasicAuth()
+		_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{}}`))
+	}))
+	defer server.Close()
+
+	// Credentials come only from config.yaml in the working directory
+	for _, key := range []string{"JIRA_URL", "JIRA_USER_EMAIL", "JIRA_API_TOKEN"} {
+		t.Setenv(key, "")
+		t.Setenv("JIRA_MCP_"+key, "")
+	}
+	dir := t.TempDir()
+	configYAML := "jira_url: " + server.URL + "\njira_user_email: file@example.com\njira_api_token: file-token\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(configYAML), 0o600))
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	cfg, err := config.Load(viper.New())
+	require.NoError(t, err)
+
+	client, err := jira.NewClient(cfg.JiraConfig(), server.Client())
+	require.NoError(t, err)
+
+	_, err = client.GetIssue(context.Background(), "TEST-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "file@example.com", gotUser)
+	assert.Equal(t, "file-token", gotToken)
+}
+
+func TestConfig_LogValueRedactsSecrets(t *testing.T) {
+	cfg := &config.Config{
+		Port:      "8080",
+		JiraURL:   "https://example.atlassian.net/some/path",
+		UserEmail: "bot@example.com",
+		APIToken:  "super-secret-token",
+
+		AdminAPIKey: "admin-secret-key",
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	logger.Info("Effective configuration loaded", "config", cfg)
+
+	out := buf.String()
+	assert.NotContains(t, out, "super-secret-token", "API token must never be logged")
+	assert.Contains(t, out, `"jira_api_token":"[REDACTED]"`)
+	assert.NotContains(t, out, "admin-secret-key", "Admin API key must never be logged")
+	assert.Contains(t, out, `"admin_api_key_set":true`)
+	assert.Contains(t, out, `"jira_host":"example.atlassian.net"`)
+	assert.Contains(t, out, `"port":"8080"`)
+}
+
+func TestParseRouteTimeouts(t *testing.T) {
+	timeouts, err := config.ParseRouteTimeouts(" search:30s, get:5s ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"search": 30 * time.Second, "get": 5 * time.Second}, timeouts)
+
+	_, err = config.ParseRouteTimeouts("search=30s")
+	assert.ErrorContains(t, err, `invalid ROUTE_TIMEOUTS entry "search=30s"`)
+
+	_, err = config.ParseRouteTimeouts("search:soon")
+	assert.ErrorContains(t, err, "invalid ROUTE_TIMEOUTS duration")
+}
+
+func TestParseRateLimitHosts(t *testing.T) {
+	rates, err := config.ParseRateLimitHosts(" a.atlassian.net=5, jira.internal:8443=0.5 ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"a.atlassian.net": 5, "jira.internal:8443": 0.5}, rates)
+
+	_, err = config.ParseRateLimitHosts("a.atlassian.net:5")
+	assert.ErrorContains(t, err, `invalid RATE_LIMIT_HOSTS entry "a.atlassian.net:5"`)
+
+	_, err = config.ParseRateLimitHosts("a.atlassian.net=fast")
+	assert.ErrorContains(t, err, "invalid RATE_LIMIT_HOSTS rate")
+}
+
+func TestParseFieldAliases(t *testing.T) {
+	aliases, err := config.ParseFieldAliases(" customfield_10020=story_points, customfield_10014 = epic_link ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"customfield_10020": "story_points", "customfield_10014": "epic_link"}, aliases)
+
+	_, err = config.ParseFieldAliases("customfield_10020:story_points")
+	assert.ErrorContains(t, err, `invalid RESPONSE_FIELD_ALIASES entry "customfield_10020:story_points"`)
+}
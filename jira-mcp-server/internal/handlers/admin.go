@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// ReloadableHandler serves requests through an http.Handler that can be swapped
+// atomically at runtime, e.g. after the configuration has been re-read. Requests
+// already in flight finish against the handler they started with.
+type ReloadableHandler struct {
+	current atomic.Pointer[http.Handler]
+}
+
+// NewReloadableHandler creates a ReloadableHandler initially serving h.
+func NewReloadableHandler(h http.Handler) *ReloadableHandler {
+	rh := &ReloadableHandler{}
+	rh.Swap(h)
+	return rh
+}
+
+// Swap replaces the handler used for subsequent requests.
+func (rh *ReloadableHandler) Swap(h http.Handler) {
+	rh.current.Store(&h)
+}
+
+// ServeHTTP implements http.Handler.
+func (rh *ReloadableHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	(*rh.current.Load()).ServeHTTP(w, r)
+}
+
+// AdminHandlers holds dependencies for operational endpoints under /admin.
+// All of them require APIKey to be sent as "Authorization: Bearer <key>" or "X-API-Key".
+type AdminHandlers struct {
+	// APIKey is the shared secret required by admin endpoints. If empty, every
+	// admin request is rejected.
+	APIKey string
+
+	// Reload re-reads the configuration and applies it. Called by ReloadHandler.
+	Reload func() error
+
+	Logger *slog.Logger
+}
+
+// ReloadHandler handles POST requests to /admin/reload.
+func (a *AdminHandlers) ReloadHandler(w http.ResponseWriter, r *http.Request) {
+	a.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if !a.authorized(r) {
+		a.Logger.WarnContext(r.Context(), "Rejected unauthorized admin request", "path", r.URL.Path, "remote", r.RemoteAddr)
+		respondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	if err := a.Reload(); err != nil {
+		// The previous settings stay in effect
+		a.Logger.ErrorContext(r.Context(), "Failed to reload configuration", "error", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to reload configuration")
+		return
+	}
+
+	a.Logger.InfoContext(r.Context(), "Configuration reloaded")
+	respondWithJSON(w, http.StatusOK, map[string]string{"status": "reloaded"})
+}
+
+// authorized reports whether the request carries the admin API key.
+func (a *AdminHandlers) authorized(r *http.Request) bool {
+	return a.APIKey != "" && hasAPIKey(r, a.APIKey)
+}
+
+// hasAPIKey reports whether the request carries key as "Authorization: Bearer <key>"
+// or "X-API-Key: <key>". The comparison takes constant time.
+func hasAPIKey(r *http.Request, key string) bool {
+	sent := r.Header.Get("X-API-Key")
+	if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		sent = bearer
+	}
+	return subtle.ConstantTimeCompare([]byte(sent), []byte(key)) == 1
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestAdminReload_AppliesNewCap(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	newRouter := func(maxFields int) http.Handler {
+		h := NewJiraHandlers(mockService, testLogger)
+		h.MaxFields = maxFields
+		r := mux.NewRouter()
+		r.HandleFunc("/jira_issue/{issueKey}", h.GetIssueDetailsHandler).Methods("GET")
+		return r
+	}
+
+	app := NewReloadableHandler(newRouter(10))
+	admin := &AdminHandlers{
+		APIKey: "s3cret",
+		Logger: testLogger,
+		Reload: func() error {
+			app.Swap(newRouter(1))
+			return nil
+		},
+	}
+
+	mockService.On("GetIssue", mock.Anything, "PROJ-1", []string{"summary", "status"}).Return(&jira.Issue{Key: "PROJ-1"}, nil).Once()
+
+	getIssue := func() *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1?fields=summary,status", nil))
+		return rr
+	}
+
+	require.Equal(t, http.StatusOK, getIssue().Code)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/reload", nil)
+	req.Header.Set("Authorization", "Bearer s3cret")
+	rr := httptest.NewRecorder()
+	admin.ReloadHandler(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	after := getIssue()
+	assert.Equal(t, http.StatusBadRequest, after.Code)
+	assert.Contains(t, after.Body.String(), "at most 1 fields")
+	mockService.AssertExpectations(t)
+}
+
+func TestAdminReload_Unauthorized(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	reloaded := false
+	reload := func() error { reloaded = true; return nil }
+
+	cases := []struct {
+		name   string
+		apiKey string
+		header string
+		value  string
+	}{
+		{"Missing key", "s3cret", "", ""},
+		{"Wrong key", "s3cret", "X-API-Key", "guess"},
+		{"Admin key not configured", "", "X-API-Key", ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			admin := &AdminHandlers{APIKey: tc.apiKey, Reload: reload, Logger: testLogger}
+			req := httptest.NewRequest(http.MethodPost, "/admin/reload", strings.NewReader(""))
+			if tc.header != "" {
+				req.Header.Set(tc.header, tc.value)
+			}
+			rr := httptest.NewRecorder()
+
+			admin.ReloadHandler(rr, req)
+
+			assert.Equal(t, http.StatusUnauthorized, rr.Code)
+			require.JSONEq(t, `{"error":"Unauthorized"}`, rr.Body.String())
+		})
+	}
+	assert.False(t, reloaded)
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+)
+
+// APIKeyAuth requires a shared secret on every request to the server, so that
+// reaching the port is not enough to act on JIRA with the server's credentials.
+type APIKeyAuth struct {
+	key    string
+	exempt map[string]bool
+	logger *slog.Logger
+}
+
+// NewAPIKeyAuth returns an APIKeyAuth requiring key, except on the exempt paths
+// (exact matches, e.g. "/healthz"). An empty key disables the check.
+func NewAPIKeyAuth(key string, exemptPaths []string, logger *slog.Logger) *APIKeyAuth {
+	exempt := make(map[string]bool, len(exemptPaths))
+	for _, path := range exemptPaths {
+		exempt[path] = true
+	}
+	return &APIKeyAuth{key: key, exempt: exempt, logger: logger}
+}
+
+// Middleware rejects requests that do not carry the key as "Authorization: Bearer <key>"
+// or "X-API-Key: <key>" with 401 Unauthorized. Without a key it returns next unchanged.
+func (a *APIKeyAuth) Middleware(next http.Handler) http.Handler {
+	if a.key == "" {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.exempt[r.URL.Path] || hasAPIKey(r, a.key) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		a.logger.WarnContext(r.Context(), "Rejected request without a valid API key", "path", r.URL.Path, "remote", r.RemoteAddr)
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		respondWithError(w, http.StatusUnauthorized, "Unauthorized")
+	})
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyAuthMiddleware(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	serve := func(handler http.Handler, path string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	handler := NewAPIKeyAuth("server-secret", []string{"/healthz"}, testLogger).Middleware(next)
+
+	t.Run("Bearer Key", func(t *testing.T) {
+		rr := serve(handler, "/create_jira_issue", map[string]string{"Authorization": "Bearer server-secret"})
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("X-API-Key Header", func(t *testing.T) {
+		rr := serve(handler, "/create_jira_issue", map[string]string{"X-API-Key": "server-secret"})
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Missing Key", func(t *testing.T) {
+		rr := serve(handler, "/create_jira_issue", nil)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+		require.JSONEq(t, `{"error":"Unauthorized"}`, rr.Body.String())
+	})
+
+	t.Run("Wrong Key", func(t *testing.T) {
+		rr := serve(handler, "/create_jira_issue", map[string]string{"Authorization": "Bearer guess"})
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("Exempt Path", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(handler, "/healthz", nil).Code)
+		assert.Equal(t, http.StatusUnauthorized, serve(handler, "/healthz/extra", nil).Code, "Only exact paths are exempt")
+	})
+
+	t.Run("Disabled Without Key", func(t *testing.T) {
+		rr := serve(NewAPIKeyAuth("", nil, testLogger).Middleware(next), "/create_jira_issue", nil)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
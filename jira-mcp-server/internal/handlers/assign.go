@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// assignIssueRequest is the body accepted by AssignIssueHandler. Assignee and
+// AccountID are synonyms, kept raw so that an explicit null (unassign) can be told
+// apart from a missing field.
+type assignIssueRequest struct {
+	Assignee  json.RawMessage `json:"assignee"`
+	AccountID json.RawMessage `json:"account_id"`
+	Email     string          `json:"email"`
+}
+
+// AssignIssueHandler handles PUT requests to /jira_issue/{issueKey}/assignee.
+// {"assignee": "<accountId>"} (or "account_id") assigns a user, {"email": "..."}
+// assigns the user with that email, {"assignee": null} unassigns the issue, and
+// {"assignee": "default"} or "automatic" assigns the project's default assignee.
+// It returns 204 on success.
+func (h *JiraHandlers) AssignIssueHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPut {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	var req assignIssueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	req.Email = strings.TrimSpace(req.Email)
+	given := 0
+	for _, set := range []bool{len(req.Assignee) > 0, len(req.AccountID) > 0, req.Email != ""} {
+		if set {
+			given++
+		}
+	}
+	if given != 1 {
+		respondWithError(w, http.StatusBadRequest, "Exactly one of assignee, account_id or email is required")
+		return
+	}
+	if len(req.AccountID) > 0 {
+		req.Assignee = req.AccountID
+	}
+
+	ctx := r.Context()
+	var err error
+	switch {
+	case req.Email != "":
+		// Unknown and ambiguous emails are reported by mapJiraError as 400 and 409
+		var accountID string
+		if accountID, err = h.JiraSvc.ResolveAccountID(ctx, req.Email); err == nil {
+			err = h.JiraSvc.AssignIssue(ctx, issueKey, accountID)
+		}
+	case bytes.Equal(req.Assignee, []byte("null")):
+		err = h.JiraSvc.UnassignIssue(ctx, issueKey)
+	default:
+		var assignee string
+		if json.Unmarshal(req.Assignee, &assignee) != nil || strings.TrimSpace(assignee) == "" {
+			respondWithError(w, http.StatusBadRequest, `Invalid assignee: expected an accountId, "default", "automatic" or null`)
+			return
+		}
+		err = h.JiraSvc.AssignIssue(ctx, issueKey, assigneeAccountID(assignee))
+	}
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error assigning JIRA issue", "issueKey", issueKey, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// assigneeAccountID maps the "default" and "automatic" keywords to JIRA's
+// default-assignee accountId and returns any other value unchanged.
+func assigneeAccountID(assignee string) string {
+	switch strings.ToLower(strings.TrimSpace(assignee)) {
+	case "default", "automatic":
+		return jira.DefaultAssigneeAccountID
+	}
+	return strings.TrimSpace(assignee)
+}
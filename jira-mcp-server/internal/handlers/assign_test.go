@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestAssignIssueHandler(t *testing.T) {
+	testCases := []struct {
+		name  string
+		body  string
+		setup func(*mockJiraService)
+	}{
+		{
+			name:  "Specific User",
+			body:  `{"assignee":"abc-1"}`,
+			setup: func(m *mockJiraService) { m.On("AssignIssue", mock.Anything, "PROJ-1", "abc-1").Return(nil) },
+		},
+		{
+			name:  "Default",
+			body:  `{"assignee":"default"}`,
+			setup: func(m *mockJiraService) { m.On("AssignIssue", mock.Anything, "PROJ-1", "-1").Return(nil) },
+		},
+		{
+			name:  "Automatic",
+			body:  `{"assignee":"automatic"}`,
+			setup: func(m *mockJiraService) { m.On("AssignIssue", mock.Anything, "PROJ-1", "-1").Return(nil) },
+		},
+		{
+			name:  "Unassigned",
+			body:  `{"assignee":null}`,
+			setup: func(m *mockJiraService) { m.On("UnassignIssue", mock.Anything, "PROJ-1").Return(nil) },
+		},
+		{
+			name:  "Account ID",
+			body:  `{"account_id":"abc-1"}`,
+			setup: func(m *mockJiraService) { m.On("AssignIssue", mock.Anything, "PROJ-1", "abc-1").Return(nil) },
+		},
+		{
+			name:  "Account ID Null",
+			body:  `{"account_id":null}`,
+			setup: func(m *mockJiraService) { m.On("UnassignIssue", mock.Anything, "PROJ-1").Return(nil) },
+		},
+		{
+			name: "Email",
+			body: `{"email":"dev@example.com"}`,
+			setup: func(m *mockJiraService) {
+				m.On("ResolveAccountID", mock.Anything, "dev@example.com").Return("abc-1", nil)
+				m.On("AssignIssue", mock.Anything, "PROJ-1", "abc-1").Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+			handlers := NewJiraHandlers(mockService, testLogger)
+			tc.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPut, "/jira_issue/PROJ-1/assignee", strings.NewReader(tc.body))
+			req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+			rr := httptest.NewRecorder()
+
+			handlers.AssignIssueHandler(rr, req)
+
+			assert.Equal(t, http.StatusNoContent, rr.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAssignIssueHandler_InvalidBody(t *testing.T) {
+	for _, body := range []string{`{}`, `{"assignee":""}`, `{"assignee":42}`, `{"assignee":"abc-1","email":"dev@example.com"}`} {
+		t.Run(body, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+			handlers := NewJiraHandlers(mockService, testLogger)
+
+			req := httptest.NewRequest(http.MethodPut, "/jira_issue/PROJ-1/assignee", strings.NewReader(body))
+			req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+			rr := httptest.NewRecorder()
+
+			handlers.AssignIssueHandler(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			mockService.AssertNotCalled(t, "AssignIssue", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAssignIssueHandler_UnknownEmail(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	mockService.On("ResolveAccountID", mock.Anything, "nobody@example.com").Return("", &jira.UserNotFoundError{Email: "nobody@example.com"})
+
+	req := httptest.NewRequest(http.MethodPut, "/jira_issue/PROJ-1/assignee", strings.NewReader(`{"email":"nobody@example.com"}`))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.AssignIssueHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.JSONEq(t, `{"error":"No JIRA user found for email nobody@example.com."}`, rr.Body.String())
+	mockService.AssertNotCalled(t, "AssignIssue", mock.Anything, mock.Anything, mock.Anything)
+}
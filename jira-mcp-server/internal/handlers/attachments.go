@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// addAttachmentFromURLRequest is the body accepted by AddAttachmentFromURLHandler.
+type addAttachmentFromURLRequest struct {
+	URL      string `json:"url"`
+	Filename string `json:"filename,omitempty"`
+}
+
+// AddAttachmentFromURLHandler handles POST requests to /jira_issue/{issueKey}/attachments/from_url.
+// It accepts {"url": "...", "filename": "..."}, downloads the URL (subject to the
+// client's host allowlist and size cap) and attaches it to the issue, returning 204.
+func (h *JiraHandlers) AddAttachmentFromURLHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	var req addAttachmentFromURLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	if strings.TrimSpace(req.URL) == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing required field: url")
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.JiraSvc.AddAttachmentFromURL(ctx, issueKey, req.URL, req.Filename); err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error attaching file from URL to JIRA issue", "issueKey", issueKey, "url", req.URL, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestAddAttachmentFromURLHandler_Success(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	mockService.On("AddAttachmentFromURL", mock.Anything, "PROJ-1", "https://files.example.com/build.log", "build.log").Return(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/jira_issue/PROJ-1/attachments/from_url",
+		strings.NewReader(`{"url":"https://files.example.com/build.log","filename":"build.log"}`))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.AddAttachmentFromURLHandler(rr, req)
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestAddAttachmentFromURLHandler_BlockedHost(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	mockService.On("AddAttachmentFromURL", mock.Anything, "PROJ-1", "http://169.254.169.254/latest", "").
+		Return(&jira.AttachmentSourceError{URL: "http://169.254.169.254/latest", Reason: "host 169.254.169.254 is not allowed"})
+
+	req := httptest.NewRequest(http.MethodPost, "/jira_issue/PROJ-1/attachments/from_url",
+		strings.NewReader(`{"url":"http://169.254.169.254/latest"}`))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.AddAttachmentFromURLHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	require.JSONEq(t, `{"error":"Cannot attach from URL: host 169.254.169.254 is not allowed."}`, rr.Body.String())
+}
+
+func TestAddAttachmentFromURLHandler_MissingURL(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodPost, "/jira_issue/PROJ-1/attachments/from_url", strings.NewReader(`{"filename":"x.txt"}`))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.AddAttachmentFromURLHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	require.JSONEq(t, `{"error":"Missing required field: url"}`, rr.Body.String())
+	mockService.AssertNotCalled(t, "AddAttachmentFromURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,315 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// MaxBatchOperations is the maximum number of operations accepted by BatchHandler.
+const MaxBatchOperations = 20
+
+// prevKeyPlaceholder is replaced in an operation's args by the key produced by the
+// previous operation, e.g. the key of a just-created issue.
+const prevKeyPlaceholder = "${prev.key}"
+
+// BatchOperation is one entry of a /jira_batch request.
+type BatchOperation struct {
+	Op   string          `json:"op"`
+	Args json.RawMessage `json:"args"`
+}
+
+// BatchResult reports the outcome of one executed batch operation. Status is the
+// HTTP status the equivalent single-operation endpoint would have returned.
+type BatchResult struct {
+	Index  int         `json:"index"`
+	Op     string      `json:"op"`
+	Status int         `json:"status"`
+	Key    string      `json:"key,omitempty"`
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// BatchFailure describes a failed operation in the "failed" list of a /jira_batch
+// response. Input is the operation as submitted, before ${prev.key} substitution.
+type BatchFailure struct {
+	Index  int            `json:"index"`
+	Input  BatchOperation `json:"input"`
+	Error  string         `json:"error"`
+	Status int            `json:"status"`
+}
+
+// batchArgsError is returned by batch operations for invalid arguments (400).
+type batchArgsError struct {
+	message string
+}
+
+func (e *batchArgsError) Error() string { return e.message }
+
+// batchOpFunc executes one batch operation. It returns the key the operation
+// produced or acted on, which later operations can reference as ${prev.key}.
+type batchOpFunc func(h *JiraHandlers, ctx context.Context, args json.RawMessage) (key string, result interface{}, err error)
+
+// batchOps dispatches batch operations by name.
+var batchOps = map[string]batchOpFunc{
+	"create":     batchCreate,
+	"comment":    batchComment,
+	"transition": batchTransition,
+	"link":       batchLink,
+}
+
+// BatchHandler handles POST requests to /jira_batch.
+// The body is an ordered list of operations, e.g.
+// [{"op": "create", "args": {...}}, {"op": "comment", "args": {"issue_key": "${prev.key}", ...}}].
+// Operations run in order; by default the batch stops at the first failure, while
+// ?on_error=continue runs the remaining operations anyway. The response lists the
+// result of every executed operation, and splits them into "succeeded" results and
+// "failed" entries carrying the input, error and status of each failure.
+func (h *JiraHandlers) BatchHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	continueOnError := false
+	switch r.URL.Query().Get("on_error") {
+	case "", "stop":
+	case "continue":
+		continueOnError = true
+	default:
+		respondWithError(w, http.StatusBadRequest, "Invalid on_error: expected stop or continue")
+		return
+	}
+
+	var ops []BatchOperation
+	if err := json.NewDecoder(r.Body).Decode(&ops); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	if len(ops) == 0 {
+		respondWithError(w, http.StatusBadRequest, "Missing operations")
+		return
+	}
+	if len(ops) > MaxBatchOperations {
+		respondWithError(w, http.StatusBadRequest, fmt.Sprintf("Too many operations: at most %d operations are allowed per batch", MaxBatchOperations))
+		return
+	}
+	for i, op := range ops {
+		if _, ok := batchOps[op.Op]; !ok {
+			respondWithError(w, http.StatusBadRequest, fmt.Sprintf("Unknown operation %q at index %d", op.Op, i))
+			return
+		}
+	}
+
+	ctx := r.Context()
+	results := make([]BatchResult, 0, len(ops))
+	succeeded := []BatchResult{}
+	failed := []BatchFailure{}
+	prevKey := ""
+	stopped := false
+	for i, op := range ops {
+		result := h.runBatchOperation(ctx, i, op, prevKey)
+		results = append(results, result)
+		prevKey = result.Key
+		if result.Error == "" {
+			succeeded = append(succeeded, result)
+			continue
+		}
+		failed = append(failed, BatchFailure{Index: i, Input: op, Error: result.Error, Status: result.Status})
+		if !continueOnError {
+			stopped = i < len(ops)-1
+			break
+		}
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{
+		"results":   results,
+		"succeeded": succeeded,
+		"failed":    failed,
+		"stopped":   stopped,
+	})
+}
+
+// runBatchOperation substitutes ${prev.key} into the operation's args and executes it.
+func (h *JiraHandlers) runBatchOperation(ctx context.Context, index int, op BatchOperation, prevKey string) BatchResult {
+	result := BatchResult{Index: index, Op: op.Op}
+
+	args := string(op.Args)
+	if strings.Contains(args, prevKeyPlaceholder) {
+		if prevKey == "" {
+			result.Status = http.StatusBadRequest
+			result.Error = fmt.Sprintf("%s used but the previous operation produced no key", prevKeyPlaceholder)
+			return result
+		}
+		// Keys are JSON-escaped so they can be substituted inside string values
+		escaped, _ := json.Marshal(prevKey)
+		args = strings.ReplaceAll(args, prevKeyPlaceholder, strings.Trim(string(escaped), `"`))
+	}
+
+	key, value, err := batchOps[op.Op](h, ctx, json.RawMessage(args))
+	if err != nil {
+		var argsErr *batchArgsError
+		var notAllowed *projectNotAllowedError
+		if errors.As(err, &argsErr) {
+			result.Status, result.Error = http.StatusBadRequest, argsErr.message
+		} else if errors.As(err, &notAllowed) {
+			result.Status, result.Error = http.StatusForbidden, notAllowed.Error()
+		} else {
+			result.Status, result.Error = mapJiraError(err)
+		}
+		h.Logger.ErrorContext(ctx, "Batch operation failed", "index", index, "op", op.Op, "error", err)
+		return result
+	}
+
+	result.Status = http.StatusOK
+	result.Key = key
+	result.Result = value
+	return result
+}
+
+// decodeBatchArgs decodes an operation's args into out.
+func decodeBatchArgs(args json.RawMessage, out interface{}) error {
+	if len(args) == 0 {
+		return &batchArgsError{"Missing args"}
+	}
+	if err := json.Unmarshal(args, out); err != nil {
+		return &batchArgsError{"Invalid args"}
+	}
+	return nil
+}
+
+// batchCreate creates an issue. Args are the same as for /create_jira_issue.
+func batchCreate(h *JiraHandlers, ctx context.Context, args json.RawMessage) (string, interface{}, error) {
+	var req jira.CreateIssueRequest
+	if err := decodeBatchArgs(args, &req); err != nil {
+		return "", nil, err
+	}
+	if req.ProjectKey == "" || req.Summary == "" || req.IssueType == "" {
+		return "", nil, &batchArgsError{"Missing required fields: project_key, summary and issue_type"}
+	}
+	if h.descriptionTooLong(req.Description) {
+		return "", nil, &batchArgsError{fmt.Sprintf("Description exceeds maximum length of %d characters", h.MaxDescriptionLength)}
+	}
+	if !jira.IsTextFormat(req.DescriptionFormat) {
+		return "", nil, &batchArgsError{"Invalid description_format: expected plain or markdown"}
+	}
+	if err := h.checkCreateProject(req.ProjectKey); err != nil {
+		return "", nil, err
+	}
+
+	created, warning, err := h.createAndAssign(ctx, req)
+	if err != nil {
+		return "", nil, err
+	}
+	body := map[string]string{"id": created.ID, "key": created.Key, "browseUrl": created.BrowseURL()}
+	if !h.OmitSelf {
+		body["url"] = created.Self
+	}
+	if warning != "" {
+		body["warning"] = warning
+	}
+	return created.Key, body, nil
+}
+
+// batchComment adds a comment. Args: {"issue_key", "body", "visibility"}.
+func batchComment(h *JiraHandlers, ctx context.Context, args json.RawMessage) (string, interface{}, error) {
+	var req struct {
+		IssueKey string `json:"issue_key"`
+		jira.AddCommentRequest
+	}
+	if err := decodeBatchArgs(args, &req); err != nil {
+		return "", nil, err
+	}
+	if req.IssueKey == "" || req.Body == "" {
+		return "", nil, &batchArgsError{"Missing required fields: issue_key and body"}
+	}
+	if !jira.IsTextFormat(req.BodyFormat) {
+		return "", nil, &batchArgsError{"Invalid body_format: expected plain or markdown"}
+	}
+	if h.NormalizeIssueKeys {
+		req.IssueKey = normalizeIssueKey(req.IssueKey)
+	}
+	if !ValidIssueKey(req.IssueKey) {
+		return "", nil, &batchArgsError{invalidIssueKeyMessage}
+	}
+
+	comment, err := h.JiraSvc.AddComment(ctx, req.IssueKey, req.AddCommentRequest)
+	if err != nil {
+		return "", nil, err
+	}
+	return req.IssueKey, comment, nil
+}
+
+// batchTransition moves an issue through a workflow transition.
+// Args: {"issue_key", "transition_id" or "transition_name"}.
+func batchTransition(h *JiraHandlers, ctx context.Context, args json.RawMessage) (string, interface{}, error) {
+	var req struct {
+		IssueKey string `json:"issue_key"`
+		TransitionIssueRequest
+	}
+	if err := decodeBatchArgs(args, &req); err != nil {
+		return "", nil, err
+	}
+	req.TransitionID = strings.TrimSpace(req.TransitionID)
+	req.TransitionName = strings.TrimSpace(req.TransitionName)
+	if req.IssueKey == "" {
+		return "", nil, &batchArgsError{"Missing required field: issue_key"}
+	}
+	if (req.TransitionID == "") == (req.TransitionName == "") {
+		return "", nil, &batchArgsError{"Exactly one of transition_id or transition_name is required"}
+	}
+	if h.NormalizeIssueKeys {
+		req.IssueKey = normalizeIssueKey(req.IssueKey)
+	}
+	if !ValidIssueKey(req.IssueKey) {
+		return "", nil, &batchArgsError{invalidIssueKeyMessage}
+	}
+
+	var err error
+	if req.TransitionID != "" {
+		err = h.JiraSvc.TransitionIssue(ctx, req.IssueKey, req.TransitionID)
+	} else {
+		err = h.JiraSvc.TransitionIssueByName(ctx, req.IssueKey, req.TransitionName)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return req.IssueKey, nil, nil
+}
+
+// batchLink links two issues. Args: {"inward_key", "outward_key", "link_type"}.
+func batchLink(h *JiraHandlers, ctx context.Context, args json.RawMessage) (string, interface{}, error) {
+	var req struct {
+		InwardKey  string `json:"inward_key"`
+		OutwardKey string `json:"outward_key"`
+		LinkType   string `json:"link_type"`
+	}
+	if err := decodeBatchArgs(args, &req); err != nil {
+		return "", nil, err
+	}
+	if req.InwardKey == "" || req.OutwardKey == "" || req.LinkType == "" {
+		return "", nil, &batchArgsError{"Missing required fields: inward_key, outward_key and link_type"}
+	}
+	if h.NormalizeIssueKeys {
+		req.InwardKey = normalizeIssueKey(req.InwardKey)
+		req.OutwardKey = normalizeIssueKey(req.OutwardKey)
+	}
+	if !ValidIssueKey(req.InwardKey) || !ValidIssueKey(req.OutwardKey) {
+		return "", nil, &batchArgsError{invalidIssueKeyMessage}
+	}
+
+	if err := h.JiraSvc.LinkIssues(ctx, req.InwardKey, req.OutwardKey, req.LinkType); err != nil {
+		return "", nil, err
+	}
+	return req.InwardKey, nil, nil
+}
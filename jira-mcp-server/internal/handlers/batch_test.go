@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+type batchResponse struct {
+	Results   []BatchResult  `json:"results"`
+	Succeeded []BatchResult  `json:"succeeded"`
+	Failed    []BatchFailure `json:"failed"`
+	Stopped   bool           `json:"stopped"`
+}
+
+func TestBatchHandler_CreateThenCommentWithSubstitution(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	reqBody := `[
+		{"op": "create", "args": {"project_key": "PROJ", "summary": "Batch bug", "issue_type": "Bug"}},
+		{"op": "comment", "args": {"issue_key": "${prev.key}", "body": "Filed by ${prev.key} automation"}}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/jira_batch", strings.NewReader(reqBody))
+	rr := httptest.NewRecorder()
+
+	mockService.On("CreateIssue", mock.Anything, jira.CreateIssueRequest{ProjectKey: "PROJ", Summary: "Batch bug", IssueType: "Bug"}).
+		Return(&jira.CreateIssueResponse{Key: "PROJ-42", Self: "https://example.atlassian.net/rest/api/3/issue/10042"}, nil)
+	mockService.On("AddComment", mock.Anything, "PROJ-42", jira.AddCommentRequest{Body: "Filed by PROJ-42 automation"}).
+		Return(&jira.Comment{ID: "100"}, nil)
+
+	handlers.BatchHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp batchResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Results, 2)
+	assert.False(t, resp.Stopped)
+	assert.Equal(t, "PROJ-42", resp.Results[0].Key)
+	assert.Equal(t, http.StatusOK, resp.Results[1].Status)
+	assert.Equal(t, "PROJ-42", resp.Results[1].Key)
+	mockService.AssertExpectations(t)
+}
+
+func TestBatchHandler_CreateCommentTransition(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	handlers.NormalizeIssueKeys = true
+
+	reqBody := `[
+		{"op": "create", "args": {"project_key": "PROJ", "summary": "Batch bug", "issue_type": "Bug"}},
+		{"op": "comment", "args": {"issue_key": "${prev.key}", "body": "Picked up"}},
+		{"op": "transition", "args": {"issue_key": "${prev.key}", "transition_name": " In Progress "}},
+		{"op": "transition", "args": {"issue_key": "proj-7", "transition_id": "31"}}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/jira_batch", strings.NewReader(reqBody))
+	rr := httptest.NewRecorder()
+
+	mockService.On("CreateIssue", mock.Anything, mock.Anything).Return(&jira.CreateIssueResponse{Key: "PROJ-42"}, nil)
+	mockService.On("AddComment", mock.Anything, "PROJ-42", mock.Anything).Return(&jira.Comment{ID: "100"}, nil)
+	mockService.On("TransitionIssueByName", mock.Anything, "PROJ-42", "In Progress").Return(nil)
+	mockService.On("TransitionIssue", mock.Anything, "PROJ-7", "31").Return(nil)
+
+	handlers.BatchHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp batchResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Succeeded, 4)
+	assert.Equal(t, "PROJ-42", resp.Results[2].Key)
+	assert.Equal(t, "PROJ-7", resp.Results[3].Key, "the key should be normalized")
+	mockService.AssertExpectations(t)
+}
+
+func TestBatchHandler_TransitionInvalidArgs(t *testing.T) {
+	mockService := new(mockJiraService) // Service shouldn't be called
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	reqBody := `[
+		{"op": "transition", "args": {"issue_key": "PROJ-1"}},
+		{"op": "transition", "args": {"issue_key": "PROJ-1", "transition_id": "31", "transition_name": "Done"}},
+		{"op": "transition", "args": {"transition_id": "31"}},
+		{"op": "transition", "args": {"issue_key": "proj 1", "transition_id": "31"}}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/jira_batch?on_error=continue", strings.NewReader(reqBody))
+	rr := httptest.NewRecorder()
+
+	handlers.BatchHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp batchResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Failed, 4)
+	assert.Equal(t, "Exactly one of transition_id or transition_name is required", resp.Failed[0].Error)
+	assert.Equal(t, "Exactly one of transition_id or transition_name is required", resp.Failed[1].Error)
+	assert.Equal(t, "Missing required field: issue_key", resp.Failed[2].Error)
+	assert.Equal(t, invalidIssueKeyMessage, resp.Failed[3].Error)
+	assert.Empty(t, mockService.Calls, "JIRA should not be called")
+}
+
+func TestBatchHandler_StopsOnFirstError(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	reqBody := `[
+		{"op": "comment", "args": {"issue_key": "PROJ-404", "body": "Hello"}},
+		{"op": "link", "args": {"inward_key": "PROJ-1", "outward_key": "PROJ-2", "link_type": "Blocks"}}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/jira_batch", strings.NewReader(reqBody))
+	rr := httptest.NewRecorder()
+
+	mockService.On("AddComment", mock.Anything, "PROJ-404", mock.Anything).Return(nil, &jira.JiraAPIError{StatusCode: http.StatusNotFound})
+
+	handlers.BatchHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp batchResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Results, 1)
+	assert.True(t, resp.Stopped)
+	assert.Equal(t, http.StatusNotFound, resp.Results[0].Status)
+	assert.Equal(t, "JIRA resource not found.", resp.Results[0].Error)
+	mockService.AssertNotCalled(t, "LinkIssues", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBatchHandler_ContinueOnError(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	reqBody := `[
+		{"op": "comment", "args": {"issue_key": "PROJ-1"}},
+		{"op": "link", "args": {"inward_key": "PROJ-1", "outward_key": "PROJ-2", "link_type": "Blocks"}}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/jira_batch?on_error=continue", strings.NewReader(reqBody))
+	rr := httptest.NewRecorder()
+
+	mockService.On("LinkIssues", mock.Anything, "PROJ-1", "PROJ-2", "Blocks").Return(nil)
+
+	handlers.BatchHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp batchResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Results, 2)
+	assert.Equal(t, http.StatusBadRequest, resp.Results[0].Status)
+	assert.Equal(t, "Missing required fields: issue_key and body", resp.Results[0].Error)
+	assert.Equal(t, http.StatusOK, resp.Results[1].Status)
+	mockService.AssertExpectations(t)
+}
+
+func TestBatchHandler_InvalidIssueKey(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	reqBody := `[
+		{"op": "comment", "args": {"issue_key": "proj 1", "body": "Hello"}},
+		{"op": "link", "args": {"inward_key": "PROJ-1", "outward_key": "X-abc", "link_type": "Blocks"}}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/jira_batch?on_error=continue", strings.NewReader(reqBody))
+	rr := httptest.NewRecorder()
+
+	handlers.BatchHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp batchResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Results, 2)
+	for _, result := range resp.Results {
+		assert.Equal(t, http.StatusBadRequest, result.Status)
+		assert.Equal(t, invalidIssueKeyMessage, result.Error)
+	}
+	mockService.AssertNotCalled(t, "AddComment", mock.Anything, mock.Anything, mock.Anything)
+	mockService.AssertNotCalled(t, "LinkIssues", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBatchHandler_UnknownOperation(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodPost, "/jira_batch", strings.NewReader(`[{"op": "delete", "args": {}}]`))
+	rr := httptest.NewRecorder()
+
+	handlers.BatchHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	require.JSONEq(t, `{"error":"Unknown operation \"delete\" at index 0"}`, rr.Body.String())
+}
+
+func TestBatchHandler_CreateProjectNotAllowed(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	handlers.CreateAllowedProjects = []string{"PROJ"}
+
+	reqBody := `[{"op": "create", "args": {"project_key": "HR", "summary": "Batch bug", "issue_type": "Bug"}}]`
+	req := httptest.NewRequest(http.MethodPost, "/jira_batch", strings.NewReader(reqBody))
+	rr := httptest.NewRecorder()
+
+	handlers.BatchHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp batchResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Results, 1)
+	assert.Equal(t, http.StatusForbidden, resp.Results[0].Status)
+	assert.Equal(t, "Creating issues in project HR is not allowed", resp.Results[0].Error)
+	mockService.AssertNotCalled(t, "CreateIssue", mock.Anything, mock.Anything)
+}
+
+func TestBatchHandler_MixedOutcomeShape(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	reqBody := `[
+		{"op": "comment", "args": {"issue_key": "PROJ-404", "body": "Hello"}},
+		{"op": "link", "args": {"inward_key": "PROJ-1", "outward_key": "PROJ-2", "link_type": "Blocks"}}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/jira_batch?on_error=continue", strings.NewReader(reqBody))
+	rr := httptest.NewRecorder()
+
+	mockService.On("AddComment", mock.Anything, "PROJ-404", mock.Anything).Return(nil, &jira.JiraAPIError{StatusCode: http.StatusNotFound})
+	mockService.On("LinkIssues", mock.Anything, "PROJ-1", "PROJ-2", "Blocks").Return(nil)
+
+	handlers.BatchHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.JSONEq(t, `[{
+		"index": 0,
+		"input": {"op": "comment", "args": {"issue_key": "PROJ-404", "body": "Hello"}},
+		"error": "JIRA resource not found.",
+		"status": 404
+	}]`, string(resp["failed"]))
+	assert.JSONEq(t, `[{"index": 1, "op": "link", "status": 200, "key": "PROJ-1"}]`, string(resp["succeeded"]))
+	assert.JSONEq(t, `false`, string(resp["stopped"]))
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// CallBudgetMiddleware limits each incoming request to MaxJiraCallsPerRequest JIRA
+// API calls. Once the budget is used up, further calls fail with
+// *jira.CallBudgetExceededError, which handlers report as 502 Bad Gateway.
+// It is a no-op when MaxJiraCallsPerRequest is zero.
+func (h *JiraHandlers) CallBudgetMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.MaxJiraCallsPerRequest <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := jira.WithCallBudget(r.Context(), h.MaxJiraCallsPerRequest)
+		next.ServeHTTP(w, r.WithContext(ctx))
+
+		if calls := jira.CallsMade(ctx); calls > h.MaxJiraCallsPerRequest {
+			h.Logger.WarnContext(ctx, "Request exceeded JIRA call budget", "method", r.Method, "path", r.URL.Path, "budget", h.MaxJiraCallsPerRequest, "attempted_calls", calls)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestCallBudgetMiddleware_AbortsRunawayComposite(t *testing.T) {
+	jiraCalls := 0
+	jiraServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jiraCalls++
+		_, _ = w.Write([]byte(`{"transitions":[]}`))
+	}))
+	defer jiraServer.Close()
+
+	client, err := jira.NewClient(jira.Config{BaseURL: jiraServer.URL, UserEmail: "bot@example.com", APIToken: "token"}, jiraServer.Client())
+	require.NoError(t, err)
+
+	var logs bytes.Buffer
+	handlers := NewJiraHandlers(client, slog.New(slog.NewJSONHandler(&logs, nil)))
+	handlers.MaxJiraCallsPerRequest = 3
+
+	// A composite handler that fans out into one JIRA call per issue
+	composite := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for i := 1; i <= 10; i++ {
+			if _, err := handlers.JiraSvc.GetTransitions(r.Context(), "PROJ-1"); err != nil {
+				statusCode, userMessage := mapJiraError(err)
+				respondWithError(w, statusCode, userMessage)
+				return
+			}
+		}
+		respondWithJSON(w, http.StatusOK, nil)
+	})
+
+	rr := httptest.NewRecorder()
+	handlers.CallBudgetMiddleware(composite).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/composite", nil))
+
+	assert.Equal(t, http.StatusBadGateway, rr.Code)
+	require.JSONEq(t, `{"error":"Request exceeded its budget of 3 JIRA calls."}`, rr.Body.String())
+	assert.Equal(t, 3, jiraCalls)
+	assert.Contains(t, logs.String(), `"msg":"Request exceeded JIRA call budget"`)
+	assert.Contains(t, logs.String(), `"path":"/composite"`)
+}
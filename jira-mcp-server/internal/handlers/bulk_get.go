@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// bulkGetRequest is the body accepted by BulkGetIssuesHandler.
+type bulkGetRequest struct {
+	Keys   []string `json:"keys"`
+	Fields []string `json:"fields,omitempty"`
+}
+
+// BulkGetIssuesHandler handles POST requests to /jira_issues/batch.
+// It accepts {"keys": ["PROJ-1", ...], "fields": [...]} and returns
+// {"issues": [...]}, leaving out issues that do not exist.
+func (h *JiraHandlers) BulkGetIssuesHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req bulkGetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	keys := make([]string, 0, len(req.Keys))
+	for _, key := range req.Keys {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		if h.NormalizeIssueKeys {
+			key = normalizeIssueKey(key)
+		}
+		if !ValidIssueKey(key) {
+			respondWithError(w, http.StatusBadRequest, fmt.Sprintf("%s (got %q)", invalidIssueKeyMessage, key))
+			return
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		respondWithError(w, http.StatusBadRequest, "Missing required field: keys")
+		return
+	}
+	if len(keys) > jira.MaxBulkFetchIssues {
+		respondWithError(w, http.StatusBadRequest, fmt.Sprintf("Too many keys: at most %d issues can be fetched at once", jira.MaxBulkFetchIssues))
+		return
+	}
+	if h.tooManyFields(req.Fields) {
+		respondWithError(w, http.StatusBadRequest, h.tooManyFieldsMessage())
+		return
+	}
+
+	ctx := r.Context()
+	issues, err := h.JiraSvc.BulkFetchIssues(ctx, keys, req.Fields)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error bulk fetching JIRA issues", "count", len(keys), "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	h.prepareIssuesResponse(r, issues)
+	respondWithJSON(w, http.StatusOK, map[string][]jira.Issue{"issues": issues})
+}
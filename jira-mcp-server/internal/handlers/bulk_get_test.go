@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestBulkGetIssuesHandler_Success(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	handlers.NormalizeIssueKeys = true
+
+	mockService.On("BulkFetchIssues", mock.Anything, []string{"PROJ-1", "PROJ-2"}, []string{"summary"}).Return([]jira.Issue{
+		{Key: "PROJ-1", Fields: map[string]interface{}{"summary": "First"}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/jira_issues/batch", strings.NewReader(`{"keys":["proj-1"," PROJ-2 "],"fields":["summary"]}`))
+	rr := httptest.NewRecorder()
+
+	handlers.BulkGetIssuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{"issues":[{"expand":"","id":"","key":"PROJ-1","fields":{"summary":"First"}}]}`, rr.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestBulkGetIssuesHandler_Validation(t *testing.T) {
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{"Missing Keys", `{"fields":["summary"]}`},
+		{"Invalid Key", `{"keys":["PROJ-1","proj 2"]}`},
+		{"Too Many Keys", `{"keys":["PROJ-1"` + strings.Repeat(`,"PROJ-1"`, jira.MaxBulkFetchIssues) + `]}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+			handlers := NewJiraHandlers(mockService, testLogger)
+
+			req := httptest.NewRequest(http.MethodPost, "/jira_issues/batch", strings.NewReader(tc.body))
+			rr := httptest.NewRecorder()
+
+			handlers.BulkGetIssuesHandler(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			mockService.AssertNotCalled(t, "BulkFetchIssues", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import "net/http"
+
+// CapabilitiesHandler handles GET requests to /capabilities.
+// It reports the server's enabled features and key settings so clients can
+// discover them at runtime instead of relying on documentation.
+func (h *JiraHandlers) CapabilitiesHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if h.Capabilities == nil {
+		respondWithJSON(w, http.StatusOK, map[string]interface{}{})
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, h.Capabilities)
+}
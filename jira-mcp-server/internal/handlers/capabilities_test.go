@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapabilitiesHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(new(mockJiraService), testLogger)
+	handlers.Capabilities = map[string]interface{}{"features": map[string]bool{"prevalidate_create": true}}
+
+	req := httptest.NewRequest(http.MethodGet, "/capabilities", nil)
+	rr := httptest.NewRecorder()
+
+	handlers.CapabilitiesHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{"features":{"prevalidate_create":true}}`, rr.Body.String())
+}
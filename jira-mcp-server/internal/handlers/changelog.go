@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+)
+
+// GetChangelogHandler handles GET requests to /jira_issue/{issueKey}/changelog.
+// It returns the issue's full change history, oldest first, as
+// [{id, author, created, items: [{field, from, fromString, to, toString, ...}]}].
+func (h *JiraHandlers) GetChangelogHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	ctx := r.Context()
+	entries, err := h.JiraSvc.GetChangelog(ctx, issueKey)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error fetching JIRA issue changelog", "issueKey", issueKey, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, entries)
+}
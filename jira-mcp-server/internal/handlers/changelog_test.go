@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestGetChangelogHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("GetChangelog", mock.Anything, "PROJ-1").Return([]jira.ChangelogEntry{{
+			ID:      "100",
+			Author:  jira.User{AccountID: "abc", DisplayName: "Alice", Active: true},
+			Created: "2024-03-01T10:00:00.000+0000",
+			Items:   []jira.ChangelogItem{{Field: "status", FieldType: "jira", From: "1", FromString: "To Do", To: "3", ToString: "In Progress"}},
+		}}, nil)
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1/changelog", nil), map[string]string{"issueKey": "PROJ-1"})
+		rr := httptest.NewRecorder()
+		handlers.GetChangelogHandler(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `[{"id":"100","author":{"accountId":"abc","displayName":"Alice","active":true},"created":"2024-03-01T10:00:00.000+0000",
+			"items":[{"field":"status","fieldtype":"jira","from":"1","fromString":"To Do","to":"3","toString":"In Progress"}]}]`, rr.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("GetChangelog", mock.Anything, "PROJ-404").Return(nil, &jira.JiraAPIError{StatusCode: http.StatusNotFound})
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-404/changelog", nil), map[string]string{"issueKey": "PROJ-404"})
+		rr := httptest.NewRecorder()
+		handlers.GetChangelogHandler(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
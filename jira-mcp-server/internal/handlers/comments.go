@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// AddCommentHandler handles POST requests to /jira_issue/{issueKey}/comments.
+// It accepts {"body": "...", "body_format": "plain"|"markdown", "visibility": {"type": "role"|"group", "value": "..."}},
+// where body_format and visibility are optional, and returns the created comment.
+func (h *JiraHandlers) AddCommentHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	var req jira.AddCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	if req.Body == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing required field: body")
+		return
+	}
+	if !jira.IsTextFormat(req.BodyFormat) {
+		respondWithError(w, http.StatusBadRequest, "Invalid body_format: expected plain or markdown")
+		return
+	}
+	if v := req.Visibility; v != nil && ((v.Type != "role" && v.Type != "group") || v.Value == "") {
+		respondWithError(w, http.StatusBadRequest, "Invalid visibility: type must be \"role\" or \"group\" and value must be set")
+		return
+	}
+
+	ctx := r.Context()
+	comment, err := h.JiraSvc.AddComment(ctx, issueKey, req)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error adding comment to JIRA issue", "issueKey", issueKey, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, comment)
+}
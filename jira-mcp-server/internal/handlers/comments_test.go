@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestAddCommentHandler_WithVisibility(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	reqBody := `{"body": "Internal note", "visibility": {"type": "group", "value": "jira-administrators"}}`
+	req := httptest.NewRequest(http.MethodPost, "/jira_issue/PROJ-1/comments", strings.NewReader(reqBody))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	expectedReq := jira.AddCommentRequest{
+		Body:       "Internal note",
+		Visibility: &jira.CommentVisibility{Type: "group", Value: "jira-administrators"},
+	}
+	mockService.On("AddComment", mock.Anything, "PROJ-1", expectedReq).Return(&jira.Comment{ID: "10000", Visibility: expectedReq.Visibility}, nil)
+
+	handlers.AddCommentHandler(rr, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"visibility":{"type":"group","value":"jira-administrators"}`)
+	mockService.AssertExpectations(t)
+}
+
+func TestAddCommentHandler_BadRequest_InvalidVisibility(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	reqBody := `{"body": "Note", "visibility": {"type": "user", "value": "bob"}}`
+	req := httptest.NewRequest(http.MethodPost, "/jira_issue/PROJ-1/comments", strings.NewReader(reqBody))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.AddCommentHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "Invalid visibility")
+	mockService.AssertNotCalled(t, "AddComment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddCommentHandler_BadRequest_InvalidBodyFormat(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodPost, "/jira_issue/PROJ-1/comments", strings.NewReader(`{"body": "Note", "body_format": "wiki"}`))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.AddCommentHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	require.JSONEq(t, `{"error":"Invalid body_format: expected plain or markdown"}`, rr.Body.String())
+	mockService.AssertNotCalled(t, "AddComment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddCommentHandler_BadRequest_MissingBody(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodPost, "/jira_issue/PROJ-1/comments", strings.NewReader(`{}`))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.AddCommentHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	require.JSONEq(t, `{"error":"Missing required field: body"}`, rr.Body.String())
+	mockService.AssertNotCalled(t, "AddComment", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// issueValidators derives an ETag and Last-Modified time for an issue from its
+// "updated" field. JIRA does not support conditional GETs itself, so the
+// comparison happens here after the issue has been fetched. The ETag also covers
+// the requested fields, since different field selections produce different bodies.
+// ok is false if the issue has no parseable "updated" value.
+func issueValidators(issue *jira.Issue, fieldsQuery string) (etag string, lastModified time.Time, ok bool) {
+	updated, _ := issue.Fields["updated"].(string)
+	if updated == "" {
+		return "", time.Time{}, false
+	}
+	// Issue timestamps use the same layout as changelog entries
+	lastModified, err := time.Parse(jira.ChangelogTimeLayout, updated)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+
+	sum := sha256.Sum256([]byte(issue.Key + "\n" + updated + "\n" + fieldsQuery))
+	return `"` + hex.EncodeToString(sum[:8]) + `"`, lastModified, true
+}
+
+// notModified reports whether the request's If-None-Match or If-Modified-Since
+// header matches the given validators. If-None-Match takes precedence when present.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" {
+		since, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		// HTTP dates have second precision
+		return !lastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestGetIssueDetailsHandler_ConditionalRequests(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	issue := &jira.Issue{
+		Key:    "PROJ-1",
+		Fields: map[string]interface{}{"summary": "Polled", "updated": "2024-03-05T10:15:30.123+0000"},
+	}
+	mockService.On("GetIssue", mock.Anything, "PROJ-1", []string(nil)).Return(issue, nil)
+
+	get := func(header, value string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1", nil)
+		req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		rr := httptest.NewRecorder()
+		handlers.GetIssueDetailsHandler(rr, req)
+		return rr
+	}
+
+	first := get("", "")
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Equal(t, "Tue, 05 Mar 2024 10:15:30 GMT", first.Header().Get("Last-Modified"))
+
+	t.Run("Matching ETag", func(t *testing.T) {
+		rr := get("If-None-Match", etag)
+		assert.Equal(t, http.StatusNotModified, rr.Code)
+		assert.Empty(t, rr.Body.String())
+		assert.Equal(t, etag, rr.Header().Get("ETag"))
+	})
+
+	t.Run("Stale ETag", func(t *testing.T) {
+		rr := get("If-None-Match", `"0000000000000000"`)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("If-Modified-Since", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotModified, get("If-Modified-Since", "Tue, 05 Mar 2024 10:15:30 GMT").Code)
+		assert.Equal(t, http.StatusOK, get("If-Modified-Since", "Tue, 05 Mar 2024 10:15:29 GMT").Code)
+	})
+}
+
+func TestGetIssueDetailsHandler_NoValidatorsWithoutUpdated(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1?fields=summary", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	req.Header.Set("If-None-Match", "*")
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetIssue", mock.Anything, "PROJ-1", []string{"summary"}).Return(&jira.Issue{Key: "PROJ-1", Fields: map[string]interface{}{"summary": "x"}}, nil)
+
+	handlers.GetIssueDetailsHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, rr.Header().Get("ETag"))
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+)
+
+// ConnectionLimiter caps the number of concurrently open long-lived connections,
+// such as SSE streams, so that idle clients cannot exhaust goroutines and sockets.
+// Unlike the JiraHandlers settings it keeps state across requests, so it must be
+// created once and shared rather than rebuilt on configuration reload.
+type ConnectionLimiter struct {
+	max    int64
+	active atomic.Int64
+}
+
+// NewConnectionLimiter returns a limiter allowing at most max open connections.
+// A max of zero or less means unlimited.
+func NewConnectionLimiter(max int) *ConnectionLimiter {
+	return &ConnectionLimiter{max: int64(max)}
+}
+
+// Active returns the number of connections currently open through the limiter.
+func (l *ConnectionLimiter) Active() int {
+	return int(l.active.Load())
+}
+
+// Middleware counts each request as an open connection until its handler returns,
+// i.e. until the client disconnects from a streaming endpoint. Requests beyond the
+// limit are rejected with 503 Service Unavailable.
+func (l *ConnectionLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if n := l.active.Add(1); l.max > 0 && n > l.max {
+			l.active.Add(-1)
+			respondWithError(w, http.StatusServiceUnavailable, fmt.Sprintf("Too many open connections (limit %d); try again later.", l.max))
+			return
+		}
+		defer l.active.Add(-1)
+		next.ServeHTTP(w, r)
+	})
+}
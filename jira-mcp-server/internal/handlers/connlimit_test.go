@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionLimiter_RejectsOverflow(t *testing.T) {
+	limiter := NewConnectionLimiter(2)
+
+	// A streaming handler that stays open until released
+	release := make(chan struct{})
+	var opened sync.WaitGroup
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		opened.Done()
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	var finished sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		opened.Add(1)
+		finished.Add(1)
+		go func() {
+			defer finished.Done()
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/watch", nil))
+		}()
+	}
+	opened.Wait()
+	require.Equal(t, 2, limiter.Active())
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/watch", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.JSONEq(t, `{"error":"Too many open connections (limit 2); try again later."}`, rr.Body.String())
+	assert.Equal(t, 2, limiter.Active(), "Rejected connections must not be counted")
+
+	// Disconnecting frees the slots again
+	close(release)
+	finished.Wait()
+	assert.Equal(t, 0, limiter.Active())
+
+	opened.Add(1)
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/watch", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestConnectionLimiter_Unlimited(t *testing.T) {
+	limiter := NewConnectionLimiter(0)
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/watch", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+)
+
+// corsAllowedMethods lists the methods the API's routes accept.
+const corsAllowedMethods = "GET, POST, PUT, DELETE, OPTIONS"
+
+// corsExposedHeaders are the response headers browser clients may read.
+const corsExposedHeaders = "X-Request-ID, ETag, Last-Modified"
+
+// corsMaxAge is how long, in seconds, browsers may cache a preflight response.
+const corsMaxAge = "600"
+
+// CORS lets browser-based clients on the allowed origins call the server. It wraps
+// the whole router so that preflight requests are answered before method-specific
+// routes could reject them.
+type CORS struct {
+	origins map[string]bool
+	any     bool
+}
+
+// NewCORS returns a CORS policy for the given origins, e.g. "https://dash.example.com".
+// "*" allows every origin. With no origins CORS is disabled.
+func NewCORS(origins []string) *CORS {
+	c := &CORS{origins: make(map[string]bool, len(origins))}
+	for _, origin := range origins {
+		if origin == "*" {
+			c.any = true
+		}
+		c.origins[strings.TrimRight(strings.ToLower(origin), "/")] = true
+	}
+	return c
+}
+
+// Enabled reports whether any origin is allowed.
+func (c *CORS) Enabled() bool {
+	return len(c.origins) > 0
+}
+
+// allowed reports whether requests from origin may be read by the browser.
+func (c *CORS) allowed(origin string) bool {
+	return c.any || c.origins[strings.ToLower(origin)]
+}
+
+// Middleware adds Access-Control-Allow-* headers to responses to allowed origins
+// and answers their preflight (OPTIONS) requests with 204 No Content. Requests
+// from other origins, and all requests when CORS is disabled, pass through unchanged.
+func (c *CORS) Middleware(next http.Handler) http.Handler {
+	if !c.Enabled() {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" || !c.allowed(origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		h := w.Header()
+		h.Add("Vary", "Origin")
+		if c.any {
+			h.Set("Access-Control-Allow-Origin", "*")
+		} else {
+			h.Set("Access-Control-Allow-Origin", origin)
+		}
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			h.Add("Vary", "Access-Control-Request-Method")
+			h.Add("Vary", "Access-Control-Request-Headers")
+			h.Set("Access-Control-Allow-Methods", corsAllowedMethods)
+			if headers := r.Header.Get("Access-Control-Request-Headers"); headers != "" {
+				h.Set("Access-Control-Allow-Headers", headers)
+			}
+			h.Set("Access-Control-Max-Age", corsMaxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		h.Set("Access-Control-Expose-Headers", corsExposedHeaders)
+		next.ServeHTTP(w, r)
+	})
+}
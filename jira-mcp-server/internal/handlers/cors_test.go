@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSMiddleware(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/search_jira_issues", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods("POST")
+
+	preflight := func(handler http.Handler, origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodOptions, "/search_jira_issues", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", "POST")
+		req.Header.Set("Access-Control-Request-Headers", "content-type, x-request-id")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	post := func(handler http.Handler, origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/search_jira_issues", nil)
+		req.Header.Set("Origin", origin)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Preflight From Allowed Origin", func(t *testing.T) {
+		handler := NewCORS([]string{"https://dash.example.com"}).Middleware(router)
+
+		rr := preflight(handler, "https://dash.example.com")
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, "https://dash.example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, corsAllowedMethods, rr.Header().Get("Access-Control-Allow-Methods"))
+		assert.Equal(t, "content-type, x-request-id", rr.Header().Get("Access-Control-Allow-Headers"))
+		assert.Contains(t, rr.Header().Values("Vary"), "Origin")
+	})
+
+	t.Run("Request From Allowed Origin", func(t *testing.T) {
+		handler := NewCORS([]string{"https://Dash.example.com/"}).Middleware(router)
+
+		rr := post(handler, "https://dash.example.com")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "https://dash.example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+		assert.Contains(t, rr.Header().Get("Access-Control-Expose-Headers"), "X-Request-ID")
+	})
+
+	t.Run("Wildcard", func(t *testing.T) {
+		handler := NewCORS([]string{"*"}).Middleware(router)
+
+		rr := post(handler, "https://anything.example.org")
+
+		assert.Equal(t, "*", rr.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("Other Origin", func(t *testing.T) {
+		handler := NewCORS([]string{"https://dash.example.com"}).Middleware(router)
+
+		rr := preflight(handler, "https://evil.example.com")
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code, "The preflight should not be answered")
+		assert.Empty(t, rr.Header().Get("Access-Control-Allow-Origin"))
+
+		rr = post(handler, "https://evil.example.com")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, rr.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("Disabled Without Origins", func(t *testing.T) {
+		cors := NewCORS(nil)
+		assert.False(t, cors.Enabled())
+
+		rr := preflight(cors.Middleware(router), "https://dash.example.com")
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+		assert.Empty(t, rr.Header().Get("Access-Control-Allow-Origin"))
+	})
+}
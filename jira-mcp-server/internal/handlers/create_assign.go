@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// createAndAssign creates an issue and, if the request names an assignee, assigns it
+// in a separate call afterwards. An assignee email is resolved before creating, so that
+// unknown or ambiguous users are rejected without leaving an unassigned issue behind.
+// A failed assignment does not fail the create; it is returned as a warning instead.
+func (h *JiraHandlers) createAndAssign(ctx context.Context, req jira.CreateIssueRequest) (resp *jira.CreateIssueResponse, warning string, err error) {
+	accountID := req.AssigneeAccountID
+	if accountID == "" && req.AssigneeEmail != "" {
+		accountID, err = h.JiraSvc.ResolveAccountID(ctx, req.AssigneeEmail)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	resp, err = h.JiraSvc.CreateIssue(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	// Link the incoming request to the new issue so "who created PROJ-123?" can be
+	// answered from the logs
+	h.Logger.InfoContext(ctx, "JIRA issue created", "issue_key", resp.Key, "issue_id", resp.ID, "project", req.ProjectKey)
+	if accountID == "" {
+		return resp, "", nil
+	}
+
+	if err := h.JiraSvc.AssignIssue(ctx, resp.Key, accountID); err != nil {
+		h.Logger.WarnContext(ctx, "Created issue could not be assigned", "issueKey", resp.Key, "accountId", accountID, "error", err)
+		_, userMessage := mapJiraError(err)
+		return resp, fmt.Sprintf("Issue was created but could not be assigned: %s", userMessage), nil
+	}
+	return resp, "", nil
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// projectNotAllowedError is returned when an issue would be created in a project
+// outside CreateAllowedProjects (403).
+type projectNotAllowedError struct {
+	projectKey string
+}
+
+func (e *projectNotAllowedError) Error() string {
+	return fmt.Sprintf("Creating issues in project %s is not allowed", e.projectKey)
+}
+
+// checkCreateProject returns a *projectNotAllowedError if CreateAllowedProjects is
+// set and does not include projectKey.
+func (h *JiraHandlers) checkCreateProject(projectKey string) error {
+	if len(h.CreateAllowedProjects) == 0 {
+		return nil
+	}
+	for _, allowed := range h.CreateAllowedProjects {
+		if strings.EqualFold(allowed, projectKey) {
+			return nil
+		}
+	}
+	return &projectNotAllowedError{projectKey: projectKey}
+}
+
+// providedCreateFields returns the JIRA field keys that a CreateIssueRequest will populate.
+func providedCreateFields(req jira.CreateIssueRequest) map[string]bool {
+	provided := map[string]bool{
+		"project":   req.ProjectKey != "",
+		"summary":   req.Summary != "",
+		"issuetype": req.IssueType != "",
+	}
+	if req.Description != "" {
+		provided["description"] = true
+	}
+	if req.Environment != "" {
+		provided["environment"] = true
+	}
+	if req.ParentKey != "" {
+		provided["parent"] = true
+	}
+	for key := range req.CustomFields {
+		provided[key] = true
+	}
+	return provided
+}
+
+// prevalidateCreate checks req against the project's create metadata and returns a
+// user-facing message describing the problem, or "" if the request looks valid.
+// An issue type outside the project's issue type scheme is reported with the allowed types.
+// Failures to fetch the metadata are logged and treated as valid so that JIRA itself
+// remains the final authority.
+func (h *JiraHandlers) prevalidateCreate(ctx context.Context, req jira.CreateIssueRequest) string {
+	if req.ProjectKey == "" || req.IssueType == "" {
+		return "" // Left to the client's own required-field validation
+	}
+
+	meta, err := h.JiraSvc.GetCreateMeta(ctx, req.ProjectKey, req.IssueType)
+	var notFound *jira.IssueTypeNotFoundError
+	if errors.As(err, &notFound) {
+		// The project's issue type scheme does not include the type; JIRA would reject it opaquely
+		allowed := make([]string, 0, len(notFound.Available))
+		for _, it := range notFound.Available {
+			allowed = append(allowed, it.Name)
+		}
+		return fmt.Sprintf("Issue type %q is not allowed in project %s. Allowed types: %s", req.IssueType, req.ProjectKey, strings.Join(allowed, ", "))
+	}
+	if err != nil {
+		h.Logger.WarnContext(ctx, "Skipping create prevalidation: failed to fetch create metadata", "project", req.ProjectKey, "issueType", req.IssueType, "error", err)
+		return ""
+	}
+
+	provided := providedCreateFields(req)
+	var missing []string
+	for _, field := range meta.Fields {
+		if !field.Required || field.HasDefaultValue {
+			continue
+		}
+		key := field.Key
+		if key == "" {
+			key = field.FieldID
+		}
+		if !provided[key] {
+			missing = append(missing, fmt.Sprintf("%s (%s)", field.Name, key))
+		}
+	}
+	if len(missing) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Missing required fields for %s %s: %s", req.ProjectKey, meta.IssueType.Name, strings.Join(missing, ", "))
+}
+
+// createFieldErrorsMessage is the top-level message sent with field errors. JIRA's own
+// error messages are only logged.
+const createFieldErrorsMessage = "Invalid request data sent to JIRA."
+
+// createFieldErrors returns JIRA's field-level errors if err is a 400 from creating an
+// issue that names the offending fields. ok is false for any other error.
+func createFieldErrors(err error) (fieldErrors map[string]string, ok bool) {
+	var apiErr *jira.JiraAPIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || len(apiErr.FieldErrors) == 0 {
+		return nil, false
+	}
+	return apiErr.FieldErrors, true
+}
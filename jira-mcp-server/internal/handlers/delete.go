@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// DeleteJiraIssueHandler handles DELETE requests to /jira_issue/{issueKey}.
+// The optional ?deleteSubtasks=true query parameter deletes the issue's subtasks too.
+func (h *JiraHandlers) DeleteJiraIssueHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodDelete {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	deleteSubtasks := false
+	if raw := r.URL.Query().Get("deleteSubtasks"); raw != "" {
+		var err error
+		if deleteSubtasks, err = strconv.ParseBool(raw); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid deleteSubtasks: expected true or false")
+			return
+		}
+	}
+
+	ctx := r.Context()
+	if err := h.JiraSvc.DeleteIssue(ctx, issueKey, deleteSubtasks); err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error deleting JIRA issue", "issueKey", issueKey, "deleteSubtasks", deleteSubtasks, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{
+		"message":         "JIRA issue deleted successfully",
+		"key":             issueKey,
+		"deletedSubtasks": deleteSubtasks,
+	})
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestDeleteJiraIssueHandler_Success(t *testing.T) {
+	testCases := []struct {
+		name           string
+		target         string
+		deleteSubtasks bool
+	}{
+		{"Default Keeps Subtasks", "/jira_issue/PROJ-1", false},
+		{"Cascade", "/jira_issue/PROJ-1?deleteSubtasks=true", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+			handlers := NewJiraHandlers(mockService, testLogger)
+
+			mockService.On("DeleteIssue", mock.Anything, "PROJ-1", tc.deleteSubtasks).Return(nil)
+
+			req := httptest.NewRequest(http.MethodDelete, tc.target, nil)
+			req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+			rr := httptest.NewRecorder()
+
+			handlers.DeleteJiraIssueHandler(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Contains(t, rr.Body.String(), `"message":"JIRA issue deleted successfully"`)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteJiraIssueHandler_HasSubtasks(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	mockService.On("DeleteIssue", mock.Anything, "PROJ-1", false).Return(&jira.IssueHasSubtasksError{IssueKey: "PROJ-1"})
+
+	req := httptest.NewRequest(http.MethodDelete, "/jira_issue/PROJ-1", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.DeleteJiraIssueHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	require.JSONEq(t, `{"error":"Issue PROJ-1 has subtasks; add ?deleteSubtasks=true to delete them as well."}`, rr.Body.String())
+}
+
+func TestDeleteJiraIssueHandler_InvalidDeleteSubtasks(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodDelete, "/jira_issue/PROJ-1?deleteSubtasks=maybe", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.DeleteJiraIssueHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	mockService.AssertNotCalled(t, "DeleteIssue", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// IssueExistsHandler handles GET requests to /jira_issue/{issueKey}/exists.
+// It returns {"exists": true} or {"exists": false}; a missing issue is not an error.
+// An issue that exists but is not visible to the account yields 403.
+func (h *JiraHandlers) IssueExistsHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	ctx := r.Context()
+	exists, err := h.JiraSvc.IssueExists(ctx, issueKey)
+	if err != nil {
+		h.Logger.ErrorContext(ctx, "Error checking whether JIRA issue exists", "issueKey", issueKey, "error", err)
+		var apiErr *jira.JiraAPIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+			respondWithError(w, http.StatusForbidden, "Issue exists but the configured account does not have permission to view it.")
+			return
+		}
+		statusCode, userMessage := mapJiraError(err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]bool{"exists": exists})
+}
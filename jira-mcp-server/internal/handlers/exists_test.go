@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestIssueExistsHandler(t *testing.T) {
+	testCases := []struct {
+		name         string
+		exists       bool
+		err          error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Exists", true, nil, http.StatusOK, `{"exists":true}`},
+		{"Not Found", false, nil, http.StatusOK, `{"exists":false}`},
+		{"Permission Denied", false, &jira.JiraAPIError{StatusCode: http.StatusForbidden}, http.StatusForbidden,
+			`{"error":"Issue exists but the configured account does not have permission to view it."}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+			handlers := NewJiraHandlers(mockService, testLogger)
+
+			mockService.On("IssueExists", mock.Anything, "PROJ-1").Return(tc.exists, tc.err)
+
+			req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1/exists", nil)
+			req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+			rr := httptest.NewRecorder()
+
+			handlers.IssueExistsHandler(rr, req)
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+			require.JSONEq(t, tc.expectedBody, rr.Body.String())
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// explainResponse describes the search a JQL-building handler would run, returned
+// instead of the results when the request has ?explain=true.
+type explainResponse struct {
+	JQL         string   `json:"jql"`
+	FallbackJQL string   `json:"fallbackJql,omitempty"` // Run only if JQL matches nothing
+	StartAt     int      `json:"startAt"`
+	MaxResults  int      `json:"maxResults"`
+	Fields      []string `json:"fields,omitempty"`
+}
+
+// explainRequested reports whether the request asks for the search to be explained
+// rather than run.
+func explainRequested(r *http.Request) bool {
+	explain, _ := strconv.ParseBool(r.URL.Query().Get("explain"))
+	return explain
+}
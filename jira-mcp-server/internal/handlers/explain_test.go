@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIssuesInEpicHandler_Explain(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	mockService.On("ResolveEpicLinkField", mock.Anything).Return("customfield_10014", nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_epic/EPIC-1/issues?explain=true", nil)
+	req = mux.SetURLVars(req, map[string]string{"epicKey": "EPIC-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.GetIssuesInEpicHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{
+		"jql": "'customfield_10014' = 'EPIC-1'",
+		"fallbackJql": "parent = \"EPIC-1\"",
+		"startAt": 0,
+		"maxResults": 50
+	}`, rr.Body.String())
+	mockService.AssertNotCalled(t, "SearchIssues", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTriageHandler_Explain(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_triage?project=proj&startAt=10&explain=true", nil)
+	rr := httptest.NewRecorder()
+
+	handlers.TriageHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{
+		"jql": "project = \"PROJ\" AND assignee is EMPTY AND resolution = Unresolved ORDER BY created ASC",
+		"startAt": 10,
+		"maxResults": 50,
+		"fields": ["summary", "status", "priority", "issuetype", "created", "reporter"]
+	}`, rr.Body.String())
+	mockService.AssertNotCalled(t, "SearchIssues", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// GetFiltersHandler handles GET requests to /jira_filters.
+// It lists the saved filters owned by the configured account as [{id, name, jql}].
+func (h *JiraHandlers) GetFiltersHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	filters, err := h.JiraSvc.GetFilters(r.Context())
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(r.Context(), "Error listing JIRA filters", "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, filters)
+}
+
+// FilterIssuesHandler handles GET requests to /jira_filter/{filterID}/issues.
+// It looks up the saved filter's JQL and runs it, paged by the optional startAt and
+// maxResults query parameters; ?fields= selects the returned fields.
+func (h *JiraHandlers) FilterIssuesHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	filterID := mux.Vars(r)["filterID"]
+	if _, err := strconv.ParseUint(filterID, 10, 64); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid filter ID: expected a numeric ID")
+		return
+	}
+
+	query := r.URL.Query()
+	startAt, maxResults, problem := parsePaging(query, h.defaultMaxResults("filter_issues"))
+	if problem != "" {
+		respondWithError(w, http.StatusBadRequest, problem)
+		return
+	}
+	var fields []string
+	if v := query.Get("fields"); v != "" {
+		fields = strings.Split(v, ",")
+	}
+	if h.tooManyFields(fields) {
+		respondWithError(w, http.StatusBadRequest, h.tooManyFieldsMessage())
+		return
+	}
+
+	ctx := r.Context()
+	filter, err := h.JiraSvc.GetFilter(ctx, filterID)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error getting JIRA filter", "filterID", filterID, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	resp, err := h.JiraSvc.SearchIssues(ctx, filter.JQL, startAt, maxResults, fields)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error running JIRA filter", "filterID", filterID, "jql", filter.JQL, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	h.prepareIssuesResponse(r, resp.Issues)
+	respondWithJSON(w, http.StatusOK, resp)
+}
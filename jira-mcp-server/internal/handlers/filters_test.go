@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestGetFiltersHandler(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_filters", nil)
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetFilters", mock.Anything).Return([]jira.Filter{
+		{ID: "10000", Name: "My open bugs", JQL: "type = Bug AND assignee = currentUser()"},
+	}, nil)
+
+	handlers.GetFiltersHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `[{"id":"10000","name":"My open bugs","jql":"type = Bug AND assignee = currentUser()"}]`, rr.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestFilterIssuesHandler(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_filter/10001/issues?maxResults=10&fields=summary,priority", nil)
+	req = mux.SetURLVars(req, map[string]string{"filterID": "10001"})
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetFilter", mock.Anything, "10001").Return(&jira.Filter{ID: "10001", Name: "Release blockers", JQL: "priority = Blocker"}, nil)
+	mockService.On("SearchIssues", mock.Anything, "priority = Blocker", 0, 10, []string{"summary", "priority"}).Return(&jira.SearchResponse{
+		MaxResults: 10,
+		Total:      1,
+		Issues:     []jira.Issue{{Key: "PROJ-9", Fields: map[string]interface{}{"summary": "Crash on start"}}},
+	}, nil)
+
+	handlers.FilterIssuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{"expand":"","startAt":0,"maxResults":10,"total":1,"issues":[{"expand":"","id":"","key":"PROJ-9","fields":{"summary":"Crash on start"}}]}`, rr.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestFilterIssuesHandler_FilterNotFound(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_filter/999/issues", nil)
+	req = mux.SetURLVars(req, map[string]string{"filterID": "999"})
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetFilter", mock.Anything, "999").Return(nil, &jira.JiraAPIError{StatusCode: http.StatusNotFound})
+
+	handlers.FilterIssuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	require.JSONEq(t, `{"error":"JIRA resource not found."}`, rr.Body.String())
+	mockService.AssertNotCalled(t, "SearchIssues", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFilterIssuesHandler_InvalidID(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_filter/mine/issues", nil)
+	req = mux.SetURLVars(req, map[string]string{"filterID": "mine"})
+	rr := httptest.NewRecorder()
+
+	handlers.FilterIssuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	require.JSONEq(t, `{"error":"Invalid filter ID: expected a numeric ID"}`, rr.Body.String())
+}
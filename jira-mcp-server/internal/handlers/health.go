@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// healthCheckTimeout bounds the JIRA call made by HealthHandler so that slow
+// readiness probes fail quickly instead of piling up.
+const healthCheckTimeout = 3 * time.Second
+
+// HealthHandler handles GET requests to /healthz. It checks that JIRA is reachable
+// with the configured credentials and returns 200 {"status": "ok", "user": "<displayName>"},
+// or 503 {"status": "jira_unreachable"} if the check fails for any reason.
+func (h *JiraHandlers) HealthHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+	defer cancel()
+
+	user, err := h.JiraSvc.Ping(ctx)
+	if err != nil {
+		h.Logger.WarnContext(ctx, "Health check failed: JIRA unreachable", "error", err)
+		respondWithJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "jira_unreachable"})
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"status": "ok", "user": user.DisplayName})
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestHealthHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	t.Run("Healthy", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("Ping", mock.MatchedBy(func(ctx context.Context) bool {
+			deadline, ok := ctx.Deadline()
+			return ok && time.Until(deadline) <= healthCheckTimeout
+		})).Return(&jira.User{AccountID: "abc-123", DisplayName: "JIRA Bot"}, nil)
+
+		rr := httptest.NewRecorder()
+		handlers.HealthHandler(rr, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `{"status":"ok","user":"JIRA Bot"}`, rr.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("JIRA Unreachable", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("Ping", mock.Anything).Return(nil, &jira.JiraAPIError{StatusCode: http.StatusUnauthorized})
+
+		rr := httptest.NewRecorder()
+		handlers.HealthHandler(rr, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		require.JSONEq(t, `{"status":"jira_unreachable"}`, rr.Body.String())
+	})
+
+	t.Run("Network Error", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("Ping", mock.Anything).Return(nil, errors.New("dial tcp: connection refused"))
+
+		rr := httptest.NewRecorder()
+		handlers.HealthHandler(rr, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+}
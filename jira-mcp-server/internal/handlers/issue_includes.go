@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// issueIncludes records which extras ?include= asked to embed in a get-issue response.
+type issueIncludes struct {
+	votes    bool
+	watchers bool
+}
+
+// any reports whether any extra was requested.
+func (inc issueIncludes) any() bool {
+	return inc.votes || inc.watchers
+}
+
+// parseIssueIncludes parses a comma-separated ?include= value such as "votes,watchers".
+func parseIssueIncludes(s string) (issueIncludes, error) {
+	var inc issueIncludes
+	for _, name := range strings.Split(s, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "":
+		case "votes":
+			inc.votes = true
+		case "watchers":
+			inc.watchers = true
+		default:
+			return issueIncludes{}, fmt.Errorf("unknown include %q", strings.TrimSpace(name))
+		}
+	}
+	return inc, nil
+}
+
+// getIssueWithIncludes fetches an issue together with the requested extras, issuing
+// the JIRA calls concurrently. A failure of any of them fails the whole lookup rather
+// than returning an issue with silently missing counts.
+func (h *JiraHandlers) getIssueWithIncludes(ctx context.Context, issueKey string, fields []string, inc issueIncludes) (*jira.Issue, error) {
+	var (
+		wg          sync.WaitGroup
+		votes       *jira.Votes
+		votesErr    error
+		watchers    *jira.Watchers
+		watchersErr error
+	)
+	if inc.votes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			votes, votesErr = h.JiraSvc.GetVotes(ctx, issueKey)
+		}()
+	}
+	if inc.watchers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			watchers, watchersErr = h.JiraSvc.GetWatchers(ctx, issueKey)
+		}()
+	}
+
+	issue, err := h.JiraSvc.GetIssue(ctx, issueKey, fields)
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	if votesErr != nil {
+		return nil, votesErr
+	}
+	if watchersErr != nil {
+		return nil, watchersErr
+	}
+
+	issue.Votes = votes
+	issue.Watchers = watchers
+	return issue, nil
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestGetIssueDetailsHandler_IncludeVotesAndWatchers(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1?include=votes,watchers", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetIssue", mock.Anything, "PROJ-1", []string(nil)).Return(&jira.Issue{
+		Key:    "PROJ-1",
+		Fields: map[string]interface{}{"summary": "Popular issue", "updated": "2024-03-01T10:00:00.000+0000"},
+	}, nil)
+	mockService.On("GetVotes", mock.Anything, "PROJ-1").Return(&jira.Votes{Votes: 7, HasVoted: true}, nil)
+	mockService.On("GetWatchers", mock.Anything, "PROJ-1").Return(&jira.Watchers{
+		WatchCount: 2,
+		Watchers:   []jira.User{{AccountID: "abc-1", DisplayName: "Jane Doe", Active: true}, {AccountID: "abc-2", DisplayName: "John Roe", Active: true}},
+	}, nil)
+
+	handlers.GetIssueDetailsHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{
+		"expand": "", "id": "", "key": "PROJ-1",
+		"fields": {"summary": "Popular issue", "updated": "2024-03-01T10:00:00.000+0000"},
+		"votes": {"votes": 7, "hasVoted": true},
+		"watchers": {
+			"watchCount": 2,
+			"isWatching": false,
+			"watchers": [
+				{"accountId": "abc-1", "displayName": "Jane Doe", "active": true},
+				{"accountId": "abc-2", "displayName": "John Roe", "active": true}
+			]
+		}
+	}`, rr.Body.String())
+	assert.Empty(t, rr.Header().Get("ETag"), "Enriched responses are not cacheable by the issue's updated time")
+	mockService.AssertExpectations(t)
+}
+
+func TestGetIssueDetailsHandler_IncludeFailureFailsRequest(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1?include=votes", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetIssue", mock.Anything, "PROJ-1", []string(nil)).Return(&jira.Issue{Key: "PROJ-1"}, nil)
+	mockService.On("GetVotes", mock.Anything, "PROJ-1").Return(nil, &jira.JiraAPIError{StatusCode: http.StatusForbidden})
+
+	handlers.GetIssueDetailsHandler(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	require.JSONEq(t, `{"error":"Permission denied by JIRA."}`, rr.Body.String())
+	mockService.AssertNotCalled(t, "GetWatchers", mock.Anything, mock.Anything)
+}
+
+func TestGetIssueDetailsHandler_UnknownInclude(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1?include=likes", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.GetIssueDetailsHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	require.JSONEq(t, `{"error":"Invalid include: expected a comma-separated list of votes, watchers"}`, rr.Body.String())
+	mockService.AssertNotCalled(t, "GetIssue", mock.Anything, mock.Anything, mock.Anything)
+}
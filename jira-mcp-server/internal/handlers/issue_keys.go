@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// looseIssueKeyPattern matches issue keys in any letter case, capturing the project part.
+var looseIssueKeyPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*)-(\d+)$`)
+
+// issueKeyPattern matches issue keys such as PROJ-123 and numeric issue IDs such as 10001,
+// which JIRA accepts wherever a key is expected.
+var issueKeyPattern = regexp.MustCompile(`^(?:[A-Z][A-Z0-9_]+-\d+|\d+)$`)
+
+// invalidIssueKeyMessage is the user-facing error for malformed issue keys.
+const invalidIssueKeyMessage = "Invalid issue key format: expected a key like PROJ-123"
+
+// ValidIssueKey reports whether key is a well-formed issue key (or numeric issue ID).
+// Handlers check keys with it so that typos fail fast instead of as a JIRA 404.
+// Lowercase keys are only valid once normalized (see NormalizeIssueKeys).
+func ValidIssueKey(key string) bool {
+	return issueKeyPattern.MatchString(key)
+}
+
+// normalizeIssueKey uppercases the project portion of an issue key (proj-123 -> PROJ-123).
+// Values that don't look like an issue key (e.g. numeric issue IDs) are returned unchanged.
+func normalizeIssueKey(key string) string {
+	m := looseIssueKeyPattern.FindStringSubmatch(key)
+	if m == nil {
+		return key
+	}
+	return strings.ToUpper(m[1]) + "-" + m[2]
+}
+
+// issueKeyVar returns the issue key path variable from the request,
+// normalized if NormalizeIssueKeys is enabled.
+func (h *JiraHandlers) issueKeyVar(r *http.Request, name string) string {
+	key := mux.Vars(r)[name]
+	if h.NormalizeIssueKeys {
+		key = normalizeIssueKey(key)
+	}
+	return key
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestNormalizeIssueKey(t *testing.T) {
+	testCases := map[string]string{
+		"proj-123":  "PROJ-123",
+		"Proj2-7":   "PROJ2-7",
+		"PROJ-123":  "PROJ-123",
+		"10001":     "10001", // Numeric issue IDs are left alone
+		"not a key": "not a key",
+	}
+	for input, expected := range testCases {
+		assert.Equal(t, expected, normalizeIssueKey(input), "input %q", input)
+	}
+}
+
+func TestValidIssueKey(t *testing.T) {
+	testCases := map[string]bool{
+		"PROJ-123":    true,
+		"AB2_X-1":     true,
+		"10001":       true, // Numeric issue IDs are accepted
+		"":            false,
+		"proj-123":    false,
+		"PROJ":        false,
+		"PROJ-":       false,
+		"P-1":         false,
+		"2PROJ-1":     false,
+		"PROJ-12a":    false,
+		"PROJ-1/edit": false,
+	}
+	for input, expected := range testCases {
+		assert.Equal(t, expected, ValidIssueKey(input), "input %q", input)
+	}
+}
+
+func TestHandlers_RejectInvalidIssueKey(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	testCases := []struct {
+		name    string
+		handler func(*JiraHandlers) http.HandlerFunc
+		method  string
+		varName string
+	}{
+		{"Get Issue", func(h *JiraHandlers) http.HandlerFunc { return h.GetIssueDetailsHandler }, http.MethodGet, "issueKey"},
+		{"Update Issue", func(h *JiraHandlers) http.HandlerFunc { return h.UpdateJiraIssueHandler }, http.MethodPut, "issueKey"},
+		{"Add Comment", func(h *JiraHandlers) http.HandlerFunc { return h.AddCommentHandler }, http.MethodPost, "issueKey"},
+		{"Epic Issues", func(h *JiraHandlers) http.HandlerFunc { return h.GetIssuesInEpicHandler }, http.MethodGet, "epicKey"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			handlers := NewJiraHandlers(mockService, testLogger)
+			handlers.NormalizeIssueKeys = false
+
+			req := httptest.NewRequest(tc.method, "/", strings.NewReader(`{"summary":"s","body":"b"}`))
+			req = mux.SetURLVars(req, map[string]string{tc.varName: "proj 1"})
+			rr := httptest.NewRecorder()
+
+			tc.handler(handlers)(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), "key format: expected a key like PROJ-123")
+			assert.Empty(t, mockService.Calls, "JIRA should not be called")
+		})
+	}
+
+	t.Run("Normalized Before Validation", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		handlers.NormalizeIssueKeys = true
+		mockService.On("IssueExists", mock.Anything, "PROJ-1").Return(true, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/jira_issue/proj-1/exists", nil)
+		req = mux.SetURLVars(req, map[string]string{"issueKey": "proj-1"})
+		rr := httptest.NewRecorder()
+
+		handlers.IssueExistsHandler(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Lowercase Epic Key Normalized", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		handlers.NormalizeIssueKeys = true
+		mockService.On("ResolveEpicLinkField", mock.Anything).Return("customfield_10014", nil)
+		mockService.On("SearchIssues", mock.Anything, "'customfield_10014' = 'PROJ-1'", 0, mock.Anything, mock.Anything).
+			Return(&jira.SearchResponse{Total: 1, Issues: []jira.Issue{{Key: "PROJ-2"}}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/jira_epic/proj-1/issues", nil)
+		req = mux.SetURLVars(req, map[string]string{"epicKey": "proj-1"})
+		rr := httptest.NewRecorder()
+
+		handlers.GetIssuesInEpicHandler(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+}
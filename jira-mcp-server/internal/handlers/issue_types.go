@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// GetIssueTypesHandler handles GET requests to /jira_project/{projectKey}/issuetypes.
+// It lists the issue types that can be created in the project as
+// [{id, name, description, subtask}], so callers can pick a valid issue_type
+// (e.g. "Story" rather than "Task") before creating an issue.
+func (h *JiraHandlers) GetIssueTypesHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	projectKey := mux.Vars(r)["projectKey"]
+	if projectKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing project key in URL path")
+		return
+	}
+
+	issueTypes, err := h.JiraSvc.GetIssueTypesForProject(r.Context(), projectKey)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(r.Context(), "Error listing JIRA issue types", "projectKey", projectKey, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+	if issueTypes == nil {
+		issueTypes = []jira.IssueType{}
+	}
+
+	respondWithJSON(w, http.StatusOK, issueTypes)
+}
+
+// GetCreateMetaHandler handles GET requests to /jira_project/{projectKey}/createmeta?issue_type=Bug.
+// It returns the fields of the issue type's create screen as {projectKey, issueType, fields},
+// where each field has its key, name, required flag and, for select-type fields, the
+// allowedValues, so callers can fill in every required field before creating an issue.
+// issue_type may be a name (matched case-insensitively) or an issue type ID.
+func (h *JiraHandlers) GetCreateMetaHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	projectKey := mux.Vars(r)["projectKey"]
+	if projectKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing project key in URL path")
+		return
+	}
+	issueType := r.URL.Query().Get("issue_type")
+	if issueType == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue_type query parameter")
+		return
+	}
+
+	meta, err := h.JiraSvc.GetCreateMeta(r.Context(), projectKey, issueType)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(r.Context(), "Error fetching JIRA create metadata", "projectKey", projectKey, "issueType", issueType, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, meta)
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestGetIssueTypesHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("GetIssueTypesForProject", mock.Anything, "PROJ").Return([]jira.IssueType{
+			{ID: "10001", Name: "Story"},
+			{ID: "10003", Name: "Sub-task", Subtask: true},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/jira_project/PROJ/issuetypes", nil)
+		req = mux.SetURLVars(req, map[string]string{"projectKey": "PROJ"})
+		rr := httptest.NewRecorder()
+		handlers.GetIssueTypesHandler(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `[{"id":"10001","name":"Story","subtask":false},{"id":"10003","name":"Sub-task","subtask":true}]`, rr.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Project Not Found", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("GetIssueTypesForProject", mock.Anything, "NOPE").Return(nil, &jira.JiraAPIError{StatusCode: http.StatusNotFound})
+
+		req := httptest.NewRequest(http.MethodGet, "/jira_project/NOPE/issuetypes", nil)
+		req = mux.SetURLVars(req, map[string]string{"projectKey": "NOPE"})
+		rr := httptest.NewRecorder()
+		handlers.GetIssueTypesHandler(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		require.JSONEq(t, `{"error":"JIRA resource not found."}`, rr.Body.String())
+	})
+
+	t.Run("Missing Project Key", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+
+		req := httptest.NewRequest(http.MethodGet, "/jira_project//issuetypes", nil)
+		req = mux.SetURLVars(req, map[string]string{"projectKey": ""})
+		rr := httptest.NewRecorder()
+		handlers.GetIssueTypesHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockService.AssertNotCalled(t, "GetIssueTypesForProject", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetCreateMetaHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("GetCreateMeta", mock.Anything, "PROJ", "Bug").Return(&jira.CreateMeta{
+			ProjectKey: "PROJ",
+			IssueType:  jira.IssueType{ID: "10004", Name: "Bug"},
+			Fields: []jira.CreateMetaField{
+				{FieldID: "summary", Key: "summary", Name: "Summary", Required: true},
+				{FieldID: "priority", Key: "priority", Name: "Priority", HasDefaultValue: true, AllowedValues: []jira.AllowedValue{{ID: "1", Name: "High"}, {ID: "2", Name: "Low"}}},
+			},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/jira_project/PROJ/createmeta?issue_type=Bug", nil)
+		req = mux.SetURLVars(req, map[string]string{"projectKey": "PROJ"})
+		rr := httptest.NewRecorder()
+		handlers.GetCreateMetaHandler(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `{
+			"projectKey": "PROJ",
+			"issueType": {"id": "10004", "name": "Bug", "subtask": false},
+			"fields": [
+				{"fieldId": "summary", "key": "summary", "name": "Summary", "required": true, "hasDefaultValue": false},
+				{"fieldId": "priority", "key": "priority", "name": "Priority", "required": false, "hasDefaultValue": true,
+				 "allowedValues": [{"id": "1", "name": "High"}, {"id": "2", "name": "Low"}]}
+			]
+		}`, rr.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Unknown Issue Type", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("GetCreateMeta", mock.Anything, "PROJ", "Epic").Return(nil, &jira.IssueTypeNotFoundError{
+			ProjectKey: "PROJ",
+			IssueType:  "Epic",
+			Available:  []jira.IssueType{{ID: "10001", Name: "Story"}, {ID: "10004", Name: "Bug"}},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/jira_project/PROJ/createmeta?issue_type=Epic", nil)
+		req = mux.SetURLVars(req, map[string]string{"projectKey": "PROJ"})
+		rr := httptest.NewRecorder()
+		handlers.GetCreateMetaHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		require.JSONEq(t, `{"error":"Issue type \"Epic\" is not available in project PROJ. Available types: Story, Bug."}`, rr.Body.String())
+	})
+
+	t.Run("Missing Issue Type", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+
+		req := httptest.NewRequest(http.MethodGet, "/jira_project/PROJ/createmeta", nil)
+		req = mux.SetURLVars(req, map[string]string{"projectKey": "PROJ"})
+		rr := httptest.NewRecorder()
+		handlers.GetCreateMetaHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		require.JSONEq(t, `{"error":"Missing issue_type query parameter"}`, rr.Body.String())
+		mockService.AssertNotCalled(t, "GetCreateMeta", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
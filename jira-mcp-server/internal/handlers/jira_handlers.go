@@ -7,14 +7,16 @@ import (
 	"fmt"
 	"log/slog" // Added for structured logging
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	// "strconv" // No longer needed for parsing error string
 	// "strings" // No longer needed for parsing error string
 
 	"jira-mcp-server/internal/jira"
-
-	"github.com/gorilla/mux" // Added for path parameter extraction
 )
 
 // JiraService defines the interface for interacting with the JIRA service.
@@ -22,8 +24,43 @@ import (
 // It's defined here to avoid circular dependencies with the jira package.
 type JiraService interface {
 	CreateIssue(ctx context.Context, req jira.CreateIssueRequest) (*jira.CreateIssueResponse, error)
-	SearchIssues(ctx context.Context, jql string, maxResults int, fields []string) (*jira.SearchResponse, error)
+	SearchIssues(ctx context.Context, jql string, startAt, maxResults int, fields []string) (*jira.SearchResponse, error)
+	SearchIssuesWithExpand(ctx context.Context, jql string, startAt, maxResults int, fields []string, expand []string) (*jira.SearchResponse, error)
 	GetIssue(ctx context.Context, issueKey string, fields []string) (*jira.Issue, error)
+	GetCreateMeta(ctx context.Context, projectKey, issueTypeName string) (*jira.CreateMeta, error)
+	GetTransitions(ctx context.Context, issueKey string) ([]jira.Transition, error)
+	AddComment(ctx context.Context, issueKey string, req jira.AddCommentRequest) (*jira.Comment, error)
+	SearchAllIssues(ctx context.Context, jql string, fields []string, limit int) (*jira.SearchResponse, error)
+	RankIssues(ctx context.Context, req jira.RankIssuesRequest) error
+	GetMyPermissions(ctx context.Context, projectKey string, permissionKeys []string) (map[string]jira.Permission, error)
+	LinkIssues(ctx context.Context, inwardKey, outwardKey, linkType string) error
+	GetVotes(ctx context.Context, issueKey string) (*jira.Votes, error)
+	GetWatchers(ctx context.Context, issueKey string) (*jira.Watchers, error)
+	ResolveAccountID(ctx context.Context, email string) (string, error)
+	AssignIssue(ctx context.Context, issueKey, accountID string) error
+	GetFilters(ctx context.Context) ([]jira.Filter, error)
+	GetFilter(ctx context.Context, filterID string) (*jira.Filter, error)
+	AddAttachmentFromURL(ctx context.Context, issueKey, url, filename string) error
+	UpdateIssue(ctx context.Context, issueKey string, req jira.UpdateIssueRequest) error
+	IssueExists(ctx context.Context, issueKey string) (bool, error)
+	DeleteIssue(ctx context.Context, issueKey string, deleteSubtasks bool) error
+	UnassignIssue(ctx context.Context, issueKey string) error
+	BulkFetchIssues(ctx context.Context, keys []string, fields []string) ([]jira.Issue, error)
+	GetUser(ctx context.Context, accountID string) (*jira.User, error)
+	ResolveEpicLinkField(ctx context.Context) (string, error)
+	Ping(ctx context.Context) (*jira.User, error)
+	GetProjects(ctx context.Context) ([]jira.Project, error)
+	GetIssueTypesForProject(ctx context.Context, projectKey string) ([]jira.IssueType, error)
+	GetLinkTypes(ctx context.Context) ([]jira.LinkType, error)
+	GetCurrentUser(ctx context.Context) (*jira.User, error)
+	GetChangelog(ctx context.Context, issueKey string) ([]jira.ChangelogEntry, error)
+	SearchIssuesToken(ctx context.Context, jql string, pageToken string, maxResults int, fields []string) (*jira.SearchTokenResponse, error)
+	SearchAllIssuesToken(ctx context.Context, jql string, fields []string, limit int) (*jira.SearchResponse, error)
+	TransitionIssue(ctx context.Context, issueKey, transitionID string) error
+	TransitionIssueByName(ctx context.Context, issueKey, transitionName string) error
+	UpdateLabels(ctx context.Context, issueKey string, add, remove []string) error
+	AddWatcher(ctx context.Context, issueKey, accountID string) error
+	RemoveWatcher(ctx context.Context, issueKey, accountID string) error
 	// GetEpicIssues is implicitly covered by SearchIssues
 }
 
@@ -34,6 +71,66 @@ type JiraHandlers struct {
 	// JiraService implementation and a structured logger.
 
 	Logger *slog.Logger // Added logger field
+
+	// MaxDescriptionLength is the maximum number of characters accepted in an
+	// issue description before ADF conversion. Zero disables the check.
+	MaxDescriptionLength int
+
+	// MaxFields is the maximum number of entries accepted in a request's fields
+	// list. Zero disables the check.
+	MaxFields int
+
+	// PrevalidateCreate enables checking create requests against the project's
+	// create metadata so missing required fields are reported before calling JIRA.
+	PrevalidateCreate bool
+
+	// MaxTotalFetch is the maximum number of issues a search with ?all=true may
+	// page through. Queries matching more are rejected. Zero disables the check.
+	MaxTotalFetch int
+
+	// NormalizeIssueKeys uppercases the project portion of issue keys taken
+	// from the URL path (proj-123 -> PROJ-123) before calling JIRA.
+	NormalizeIssueKeys bool
+
+	// CreateIncludeMessage adds a human-readable "message" field to the create
+	// issue response. Enabled by default by NewJiraHandlers.
+	CreateIncludeMessage bool
+
+	// MaxJiraCallsPerRequest is the maximum number of JIRA API calls a single
+	// incoming request may make when routed through CallBudgetMiddleware.
+	// Zero disables the check.
+	MaxJiraCallsPerRequest int
+
+	// OmitSelf strips JIRA's internal REST "self" URLs from issues and create
+	// responses, leaving only browse URLs.
+	OmitSelf bool
+
+	// CreateAllowedProjects restricts issue creation to these project keys.
+	// Empty allows every project.
+	CreateAllowedProjects []string
+
+	// ResponseFieldAliases renames keys in returned issues' fields, e.g.
+	// "customfield_10020" to "story_points". Empty leaves fields unchanged.
+	ResponseFieldAliases map[string]string
+
+	// RequestTimeout is the default time a request routed through TimeoutMiddleware
+	// may take. Zero disables the timeout.
+	RequestTimeout time.Duration
+
+	// RouteTimeouts overrides RequestTimeout for individual routes, keyed by mux route name.
+	RouteTimeouts map[string]time.Duration
+
+	// DefaultMaxResults is the page size used when a request omits maxResults, keyed
+	// by mux route name. Routes without an entry use defaultMaxResults.
+	DefaultMaxResults map[string]int
+
+	// SearchAPI selects the JIRA search endpoint behind /search_jira_issues and
+	// /jira_search/map: jira.SearchAPIOffset (the default, paged with startAt) or
+	// jira.SearchAPIJQL (paged with next_page_token).
+	SearchAPI string
+
+	// Capabilities is served as JSON by CapabilitiesHandler. It must not contain secrets.
+	Capabilities interface{}
 }
 
 // NewJiraHandlers creates a new JiraHandlers instance.
@@ -44,37 +141,74 @@ func NewJiraHandlers(service jira.JiraService, logger *slog.Logger) *JiraHandler
 
 		JiraSvc: service,
 		Logger:  logger, // Assign logger
+
+		CreateIncludeMessage: true,
 	}
 }
 
 func (h *JiraHandlers) CreateJiraIssueHandler(w http.ResponseWriter, r *http.Request) {
-	h.Logger.Info("Request received", "method", r.Method, "path", r.URL.Path)
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
 	if r.Method != http.MethodPost {
 		// CreateJiraIssueHandler handles POST requests to /create_jira_issue.
 		// It parses the request body, calls the JiraService's CreateIssue method,
 		// and returns the created issue's key and URL or an error response.
 
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
 		return
 	}
 
 	// Parse request body
 	var req jira.CreateIssueRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.Logger.Error("Failed to decode request body", "error", err)
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
 		// Use the helper for consistent JSON error responses
 		respondWithError(w, http.StatusBadRequest, "Invalid request body") // Keep user message generic
 		return
 	}
 
+	if h.descriptionTooLong(req.Description) {
+		respondWithError(w, http.StatusBadRequest, fmt.Sprintf("Description exceeds maximum length of %d characters", h.MaxDescriptionLength))
+		return
+	}
+	if !jira.IsTextFormat(req.DescriptionFormat) {
+		respondWithError(w, http.StatusBadRequest, "Invalid description_format: expected plain or markdown")
+		return
+	}
+	if req.ParentKey != "" && req.EpicKey != "" {
+		respondWithError(w, http.StatusBadRequest, "Specify either parent_key or epic_key, not both")
+		return
+	}
+	if err := h.checkCreateProject(req.ProjectKey); err != nil {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+
 	// Get context from request
 	ctx := r.Context()
-	// Create issue
-	resp, err := h.JiraSvc.CreateIssue(ctx, req)
+
+	if h.PrevalidateCreate {
+		if problem := h.prevalidateCreate(ctx, req); problem != "" {
+			respondWithError(w, http.StatusBadRequest, problem)
+			return
+		}
+	}
+
+	// Create issue, then assign it if requested
+	resp, warning, err := h.createAndAssign(ctx, req)
 	if err != nil {
 		statusCode, userMessage := mapJiraError(err)
 		// Log the detailed error internally
-		h.Logger.Error("Error creating JIRA issue", "error", err)
+		h.Logger.ErrorContext(ctx, "Error creating JIRA issue", "error", err)
+		var ambiguousUser *jira.AmbiguousUserError
+		if errors.As(err, &ambiguousUser) {
+			// Let the caller disambiguate instead of guessing which user was meant
+			respondWithErrorExtensions(w, statusCode, userMessage, errorExtensions{Candidates: ambiguousUser.Candidates})
+			return
+		}
+		if fieldErrors, ok := createFieldErrors(err); ok {
+			respondWithErrorExtensions(w, statusCode, createFieldErrorsMessage, errorExtensions{FieldErrors: fieldErrors})
+			return
+		}
 		respondWithError(w, statusCode, userMessage) // Use user-friendly message
 		return
 	}
@@ -82,35 +216,80 @@ func (h *JiraHandlers) CreateJiraIssueHandler(w http.ResponseWriter, r *http.Req
 	// Return success response
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	err = json.NewEncoder(w).Encode(map[string]string{
-		"message": "JIRA issue created successfully",
-		"key":     resp.Key,
-		"url":     resp.Self,
-	})
+	body := map[string]string{
+		"id":        resp.ID,
+		"key":       resp.Key,
+		"browseUrl": resp.BrowseURL(),
+	}
+	if !h.OmitSelf {
+		body["url"] = resp.Self
+	}
+	if h.CreateIncludeMessage {
+		body["message"] = "JIRA issue created successfully"
+	}
+	if warning != "" {
+		body["warning"] = warning
+	}
+	err = json.NewEncoder(w).Encode(body)
 	if err != nil {
 		// Log error, but can't change header after WriteHeader
-		h.Logger.Error("Error encoding success response", "error", err)
+		h.Logger.ErrorContext(ctx, "Error encoding success response", "error", err)
 	}
 }
 
+// descriptionTooLong reports whether description exceeds the configured MaxDescriptionLength.
+func (h *JiraHandlers) descriptionTooLong(description string) bool {
+	return h.MaxDescriptionLength > 0 && utf8.RuneCountInString(description) > h.MaxDescriptionLength
+}
+
+// tooManyFields reports whether fields exceeds the configured MaxFields.
+func (h *JiraHandlers) tooManyFields(fields []string) bool {
+	return h.MaxFields > 0 && len(fields) > h.MaxFields
+}
+
+// tooManyFieldsMessage is the user-facing error for requests exceeding MaxFields.
+func (h *JiraHandlers) tooManyFieldsMessage() string {
+	return fmt.Sprintf("Too many fields requested: at most %d fields are allowed", h.MaxFields)
+}
+
 // Helper struct for SearchIssuesHandler request body
 type SearchRequest struct {
-	JQL string `json:"jql"`
+	JQL string `json:"jql" jsonschema:"required" description:"JQL query, e.g. project = PROJ AND status = Open"`
 	// SearchRequest defines the expected JSON structure for the request body
 	// of the SearchIssuesHandler.
 
+	StartAt    int      `json:"startAt"` // Zero-based index of the first result, default 0
 	MaxResults int      `json:"maxResults"`
 	Fields     []string `json:"fields"`
+
+	// SortBy and SortDir ("asc" or "desc", default "asc") order the results
+	// when the JQL has no ORDER BY clause of its own.
+	SortBy  string `json:"sort_by,omitempty"`
+	SortDir string `json:"sort_dir,omitempty"`
+
+	// NextPageToken continues a search from the previous page's nextPageToken.
+	// Only used when the server is configured with the jql search API.
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
 
-// Helper function to write JSON error responses
+// Helper function to write JSON error responses. Clients that accept
+// application/problem+json get RFC 7807 problem details instead (see ProblemDetailsMiddleware).
 func respondWithError(w http.ResponseWriter, code int, message string) {
+	if pw, ok := w.(*problemResponseWriter); ok {
+		respondWithProblem(pw, code, message)
+		return
+	}
 	respondWithJSON(w, code, map[string]string{"error": message})
 }
 
 // Helper function to write JSON success responses
 func respondWithJSON(w http.ResponseWriter, code int, payload interface{}) {
-	w.Header().Set("Content-Type", "application/json")
+	writeJSON(w, code, "application/json", payload)
+}
+
+// writeJSON writes payload as a JSON response with the given status code and content type.
+func writeJSON(w http.ResponseWriter, code int, contentType string, payload interface{}) {
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(code)
 	if payload != nil {
 		if err := json.NewEncoder(w).Encode(payload); err != nil {
@@ -129,12 +308,73 @@ func mapJiraError(err error) (int, string) {
 		return http.StatusOK, "" // Should not happen if called on non-nil error
 	}
 
+	var tooLarge *jira.ResultSetTooLargeError
+	if errors.As(err, &tooLarge) {
+		return http.StatusBadRequest, fmt.Sprintf("Result set too large; refine your query (%d matches).", tooLarge.Total)
+	}
+
+	var callTimeout *jira.RequestTimeoutError
+	if errors.As(err, &callTimeout) {
+		return http.StatusGatewayTimeout, fmt.Sprintf("JIRA did not respond within %s.", callTimeout.Timeout)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return http.StatusGatewayTimeout, "Timed out waiting for JIRA."
+	}
+
+	var loginPage *jira.LoginPageError
+	if errors.As(err, &loginPage) {
+		return http.StatusUnauthorized, "Authentication failed with JIRA (received login page)."
+	}
+
+	var overBudget *jira.CallBudgetExceededError
+	if errors.As(err, &overBudget) {
+		return http.StatusBadGateway, fmt.Sprintf("Request exceeded its budget of %d JIRA calls.", overBudget.Limit)
+	}
+
+	var ambiguousUser *jira.AmbiguousUserError
+	if errors.As(err, &ambiguousUser) {
+		return http.StatusConflict, fmt.Sprintf("Multiple JIRA users match %s.", ambiguousUser.Email)
+	}
+
+	var userNotFound *jira.UserNotFoundError
+	if errors.As(err, &userNotFound) {
+		return http.StatusBadRequest, fmt.Sprintf("No JIRA user found for email %s.", userNotFound.Email)
+	}
+
+	var hasSubtasks *jira.IssueHasSubtasksError
+	if errors.As(err, &hasSubtasks) {
+		return http.StatusBadRequest, fmt.Sprintf("Issue %s has subtasks; add ?deleteSubtasks=true to delete them as well.", hasSubtasks.IssueKey)
+	}
+
+	var noTransition *jira.TransitionNotFoundError
+	if errors.As(err, &noTransition) {
+		return http.StatusBadRequest, fmt.Sprintf("Transition %q is not available on %s. Available transitions: %s.", noTransition.Name, noTransition.IssueKey, strings.Join(noTransition.AvailableNames(), ", "))
+	}
+
+	var noIssueType *jira.IssueTypeNotFoundError
+	if errors.As(err, &noIssueType) {
+		names := make([]string, 0, len(noIssueType.Available))
+		for _, it := range noIssueType.Available {
+			names = append(names, it.Name)
+		}
+		return http.StatusBadRequest, fmt.Sprintf("Issue type %q is not available in project %s. Available types: %s.", noIssueType.IssueType, noIssueType.ProjectKey, strings.Join(names, ", "))
+	}
+
+	var attachmentSource *jira.AttachmentSourceError
+	if errors.As(err, &attachmentSource) {
+		return http.StatusBadRequest, fmt.Sprintf("Cannot attach from URL: %s.", attachmentSource.Reason)
+	}
+
 	var jiraAPIError *jira.JiraAPIError
 	if errors.As(err, &jiraAPIError) {
 		// We have a specific error from the JIRA API client
 		switch jiraAPIError.StatusCode {
 		case http.StatusBadRequest: // 400
-			// Consider parsing jiraAPIError.Message for more specific user feedback if safe
+			// Only JIRA's parsed error messages are forwarded, never the raw body
+			if details := jiraAPIError.Details(); details != "" {
+				return http.StatusBadRequest, "Invalid request data sent to JIRA: " + details
+			}
 			return http.StatusBadRequest, "Invalid request data sent to JIRA."
 		case http.StatusUnauthorized: // 401
 			return http.StatusUnauthorized, "Authentication failed with JIRA."
@@ -142,6 +382,8 @@ func mapJiraError(err error) (int, string) {
 			return http.StatusForbidden, "Permission denied by JIRA."
 		case http.StatusNotFound: // 404
 			return http.StatusNotFound, "JIRA resource not found."
+		case http.StatusTooManyRequests: // 429, after the client's retries ran out
+			return http.StatusTooManyRequests, "JIRA rate limit exceeded, please retry later."
 		default:
 			// Log the detailed error internally
 			// Note: Can't use the injected logger here as it's a helper function.
@@ -168,29 +410,142 @@ func mapJiraError(err error) (int, string) {
 
 // SearchIssuesHandler handles requests to search for JIRA issues.
 func (h *JiraHandlers) SearchIssuesHandler(w http.ResponseWriter, r *http.Request) {
-	h.Logger.Info("Request received", "method", r.Method, "path", r.URL.Path)
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
 	// SearchIssuesHandler handles POST requests to /search_jira_issues.
 	// It parses the request body containing JQL, maxResults, and fields,
 	// calls the JiraService's SearchIssues method, and returns the search results
 	// or an error response.
 
-	if r.Method != http.MethodPost {
-		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	resp, ok := h.runSearch(w, r)
+	if !ok {
 		return
 	}
 
-	var req SearchRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.Logger.Error("Failed to decode request body", "error", err)
-		respondWithError(w, http.StatusBadRequest, "Invalid request body") // Keep user message generic
+	// Render a Markdown table instead of JSON when requested
+	if wantsMarkdown(r) {
+		respondWithMarkdown(w, http.StatusOK, renderIssuesMarkdown(resp.Issues))
+		return
+	}
+
+	if r.URL.Query().Get("render_adf") == "true" {
+		for i := range resp.Issues {
+			resp.Issues[i].RenderDescription()
+		}
+	}
+	if r.URL.Query().Get("names") == "true" {
+		h.resolveUserNames(r.Context(), resp.Issues)
+	}
+	h.prepareIssuesResponse(r, resp.Issues)
+	if resp.IsLast != nil {
+		// A token search page has no meaningful startAt or total
+		respondWithJSON(w, http.StatusOK, map[string]interface{}{
+			"maxResults":    resp.MaxResults,
+			"issues":        resp.Issues,
+			"nextPageToken": resp.NextPageToken,
+			"isLast":        *resp.IsLast,
+		})
 		return
 	}
-	defer func() { _ = r.Body.Close() }() // Ensure body is closed
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// SearchIssuesMapHandler handles POST requests to /jira_search/map.
+// It accepts the same body as SearchIssuesHandler but returns the found issues
+// as a JSON object keyed by issue key instead of an array.
+func (h *JiraHandlers) SearchIssuesMapHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	resp, ok := h.runSearch(w, r)
+	if !ok {
+		return
+	}
+
+	h.prepareIssuesResponse(r, resp.Issues)
+	issuesByKey := make(map[string]jira.Issue, len(resp.Issues))
+	for _, issue := range resp.Issues {
+		issuesByKey[issue.Key] = issue
+	}
+
+	respondWithJSON(w, http.StatusOK, issuesByKey)
+}
+
+// searchRequestFromQuery builds a SearchRequest from the query parameters of a GET
+// search: jql, startAt, maxResults, fields (comma-separated), sort_by, sort_dir
+// and next_page_token.
+// problem is a user-facing message if a parameter is invalid.
+func searchRequestFromQuery(query url.Values) (req SearchRequest, problem string) {
+	req.JQL = query.Get("jql")
+	if v := query.Get("startAt"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return SearchRequest{}, "Invalid startAt: expected a non-negative integer"
+		}
+		req.StartAt = n
+	}
+	if v := query.Get("maxResults"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return SearchRequest{}, "Invalid maxResults: expected a positive integer"
+		}
+		req.MaxResults = n
+	}
+	if v := query.Get("fields"); v != "" {
+		req.Fields = strings.Split(v, ",")
+	}
+	req.SortBy = query.Get("sort_by")
+	req.SortDir = query.Get("sort_dir")
+	req.NextPageToken = query.Get("next_page_token")
+	return req, ""
+}
+
+// runSearch parses and validates a SearchRequest (a JSON body, or query parameters
+// for GET) and runs the search.
+// On failure it writes the error response itself and returns false.
+func (h *JiraHandlers) runSearch(w http.ResponseWriter, r *http.Request) (*jira.SearchResponse, bool) {
+	var req SearchRequest
+	switch r.Method {
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+			respondWithError(w, http.StatusBadRequest, "Invalid request body") // Keep user message generic
+			return nil, false
+		}
+		defer func() { _ = r.Body.Close() }() // Ensure body is closed
+	case http.MethodGet:
+		// Same parameters as the JSON body, for clients behind proxies that drop POST bodies
+		var problem string
+		if req, problem = searchRequestFromQuery(r.URL.Query()); problem != "" {
+			respondWithError(w, http.StatusBadRequest, problem)
+			return nil, false
+		}
+	default:
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return nil, false
+	}
 
 	// Basic validation
 	if req.JQL == "" {
 		respondWithError(w, http.StatusBadRequest, "Missing required field: jql")
-		return
+		return nil, false
+	}
+	if req.StartAt < 0 {
+		respondWithError(w, http.StatusBadRequest, "Invalid startAt: expected a non-negative integer")
+		return nil, false
+	}
+	if h.tooManyFields(req.Fields) {
+		respondWithError(w, http.StatusBadRequest, h.tooManyFieldsMessage())
+		return nil, false
+	}
+	if req.SortBy != "" {
+		dir := strings.ToUpper(req.SortDir)
+		if dir == "" {
+			dir = "ASC"
+		}
+		if dir != "ASC" && dir != "DESC" {
+			respondWithError(w, http.StatusBadRequest, "Invalid sort_dir: expected asc or desc")
+			return nil, false
+		}
+		req.JQL = appendOrderBy(req.JQL, req.SortBy, dir)
 	}
 
 	// Get context from request
@@ -198,24 +553,49 @@ func (h *JiraHandlers) SearchIssuesHandler(w http.ResponseWriter, r *http.Reques
 	// Default maxResults if not provided or zero
 	maxResults := req.MaxResults
 	if maxResults <= 0 {
-		maxResults = 50 // Default to 50 if not specified or invalid
+		maxResults = h.defaultMaxResults("search") // Default if not specified or invalid
+	}
+
+	all := r.URL.Query().Get("all") == "true"
+	tokenSearch := h.SearchAPI == jira.SearchAPIJQL
+	if tokenSearch && req.StartAt > 0 {
+		respondWithError(w, http.StatusBadRequest, "startAt is not supported by the jql search API: use next_page_token")
+		return nil, false
+	}
+	if (!tokenSearch || all) && req.NextPageToken != "" {
+		respondWithError(w, http.StatusBadRequest, "next_page_token is only supported by the jql search API")
+		return nil, false
 	}
 
-	resp, err := h.JiraSvc.SearchIssues(ctx, req.JQL, maxResults, req.Fields)
+	var resp *jira.SearchResponse
+	var err error
+	if all && tokenSearch {
+		// Page through every match, bounded by MaxTotalFetch
+		resp, err = h.JiraSvc.SearchAllIssuesToken(ctx, req.JQL, req.Fields, h.MaxTotalFetch)
+	} else if all {
+		resp, err = h.JiraSvc.SearchAllIssues(ctx, req.JQL, req.Fields, h.MaxTotalFetch)
+	} else if tokenSearch {
+		var page *jira.SearchTokenResponse
+		if page, err = h.JiraSvc.SearchIssuesToken(ctx, req.JQL, req.NextPageToken, maxResults, req.Fields); err == nil {
+			resp = &jira.SearchResponse{MaxResults: maxResults, Issues: page.Issues, NextPageToken: page.NextPageToken, IsLast: &page.IsLast}
+		}
+	} else {
+		resp, err = h.JiraSvc.SearchIssues(ctx, req.JQL, req.StartAt, maxResults, req.Fields)
+	}
 	if err != nil {
 		statusCode, userMessage := mapJiraError(err)
 		// Log the detailed error internally
-		h.Logger.Error("Error searching JIRA issues", "jql", req.JQL, "error", err)
+		h.Logger.ErrorContext(ctx, "Error searching JIRA issues", "jql", req.JQL, "error", err)
 		respondWithError(w, statusCode, userMessage) // Use user-friendly message
-		return
+		return nil, false
 	}
 
-	respondWithJSON(w, http.StatusOK, resp)
+	return resp, true
 }
 
 // GetIssueDetailsHandler handles requests to get details for a specific JIRA issue.
 func (h *JiraHandlers) GetIssueDetailsHandler(w http.ResponseWriter, r *http.Request) {
-	h.Logger.Info("Request received", "method", r.Method, "path", r.URL.Path)
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
 	// GetIssueDetailsHandler handles GET requests to /jira_issue/{issueKey}.
 	// It extracts the issueKey from the URL path, optionally parses requested fields
 	// from query parameters, calls the JiraService's GetIssue method, and returns
@@ -227,12 +607,15 @@ func (h *JiraHandlers) GetIssueDetailsHandler(w http.ResponseWriter, r *http.Req
 	}
 
 	// Extract issueKey from path parameter using mux
-	vars := mux.Vars(r)
-	issueKey := vars["issueKey"]
+	issueKey := h.issueKeyVar(r, "issueKey")
 	if issueKey == "" {
 		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
 		return
 	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
 
 	// Optional: Parse fields from query parameter
 	fieldsQuery := r.URL.Query().Get("fields")
@@ -241,24 +624,61 @@ func (h *JiraHandlers) GetIssueDetailsHandler(w http.ResponseWriter, r *http.Req
 		// Basic split, consider more robust parsing if needed
 		fields = strings.Split(fieldsQuery, ",")
 	}
+	if h.tooManyFields(fields) {
+		respondWithError(w, http.StatusBadRequest, h.tooManyFieldsMessage())
+		return
+	}
+
+	renderADF := r.URL.Query().Get("render_adf") == "true"
+
+	include, err := parseIssueIncludes(r.URL.Query().Get("include"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid include: expected a comma-separated list of votes, watchers")
+		return
+	}
 
 	// Get context from request
 	ctx := r.Context()
-	issue, err := h.JiraSvc.GetIssue(ctx, issueKey, fields)
+	issue, err := h.getIssueWithIncludes(ctx, issueKey, fields, include)
 	if err != nil {
 		statusCode, userMessage := mapJiraError(err)
 		// Log the detailed error internally
-		h.Logger.Error("Error getting JIRA issue details", "issueKey", issueKey, "error", err)
+		h.Logger.ErrorContext(ctx, "Error getting JIRA issue details", "issueKey", issueKey, "error", err)
 		respondWithError(w, statusCode, userMessage) // Use user-friendly message
 		return
 	}
 
+	// Support conditional requests so pollers can skip unchanged issues. Votes and
+	// watchers can change without touching "updated", so enriched responses are excluded.
+	variant := fieldsQuery
+	if renderADF {
+		variant += "\nrender_adf"
+	}
+	if r.URL.Query().Get("omitEmpty") == "true" {
+		variant += "\nomitEmpty"
+	}
+	if etag, lastModified, ok := issueValidators(issue, variant); ok && !include.any() {
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+		if notModified(r, etag, lastModified) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	if renderADF {
+		issue.RenderDescription()
+	}
+	h.prepareIssueResponse(r, issue)
 	respondWithJSON(w, http.StatusOK, issue)
 }
 
 // GetIssuesInEpicHandler handles requests to find issues within a specific epic.
+// The optional startAt, maxResults (at most 100) and fields query parameters page
+// and trim the result. With ?explain=true it returns the JQL it would run instead
+// of running it.
 func (h *JiraHandlers) GetIssuesInEpicHandler(w http.ResponseWriter, r *http.Request) {
-	h.Logger.Info("Request received", "method", r.Method, "path", r.URL.Path)
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
 	// GetIssuesInEpicHandler handles GET requests to /jira_epic/{epicKey}/issues.
 	// It extracts the epicKey from the URL path, constructs a JQL query to find
 	// issues linked to the epic, calls the JiraService's SearchIssues method,
@@ -269,32 +689,190 @@ func (h *JiraHandlers) GetIssuesInEpicHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// Extract epicKey from path parameter using mux
-	vars := mux.Vars(r)
-	epicKey := vars["epicKey"]
+	epicKey := h.issueKeyVar(r, "epicKey")
 	if epicKey == "" {
 		respondWithError(w, http.StatusBadRequest, "Missing epic key in URL path")
 		return
 	}
+	if !ValidIssueKey(epicKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
 
-	// Construct JQL using the EpicLinkFieldName constant from the jira package.
-	// Note the single quotes around the field name, which is often required for custom fields in JQL.
-	jql := fmt.Sprintf("'%s' = '%s'", jira.EpicLinkFieldName, epicKey) // Use single quotes for JQL string literal
+	// Paging and fields are optional; large epics are paged rather than truncated
+	query := r.URL.Query()
+	startAt, maxResults, problem := parsePaging(query, h.defaultMaxResults("epic"))
+	if problem != "" {
+		respondWithError(w, http.StatusBadRequest, problem)
+		return
+	}
+	if query.Get("maxResults") != "" && maxResults > maxEpicResults {
+		maxResults = maxEpicResults
+	}
+	var fields []string
+	for _, field := range strings.Split(query.Get("fields"), ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	if h.tooManyFields(fields) {
+		respondWithError(w, http.StatusBadRequest, h.tooManyFieldsMessage())
+		return
+	}
 
 	// Get context from request
 	ctx := r.Context()
-	// Using default search options for simplicity, could allow overrides via query params
-	defaultMaxResults := 50
-	var defaultFields []string // Or specify default fields: []string{"summary", "status", "assignee"}
 
-	resp, err := h.JiraSvc.SearchIssues(ctx, jql, defaultMaxResults, defaultFields)
+	// Construct JQL using the instance's Epic Link field, or the EpicLinkFieldName
+	// constant from the jira package if it cannot be resolved.
+	epicLinkField, err := h.JiraSvc.ResolveEpicLinkField(ctx)
+	if err != nil {
+		h.Logger.WarnContext(ctx, "Failed to resolve the Epic Link field; using the default", "default", jira.EpicLinkFieldName, "error", err)
+		epicLinkField = jira.EpicLinkFieldName
+	}
+	// Note the single quotes around the field name, which is often required for custom fields in JQL.
+	jql := fmt.Sprintf("'%s' = '%s'", epicLinkField, epicKey) // Use single quotes for JQL string literal
+
+	// Team-managed (next-gen) projects link to epics via parent; tried if jql matches nothing
+	fallbackJQL := "parent = " + quoteJQLString(epicKey)
+	if explainRequested(r) {
+		respondWithJSON(w, http.StatusOK, explainResponse{JQL: jql, FallbackJQL: fallbackJQL, StartAt: startAt, MaxResults: maxResults, Fields: fields})
+		return
+	}
+
+	resp, err := h.JiraSvc.SearchIssues(ctx, jql, startAt, maxResults, fields)
 	if err != nil {
 		statusCode, userMessage := mapJiraError(err)
 		// Log the detailed error internally
-		h.Logger.Error("Error getting issues in epic", "epicKey", epicKey, "jql", jql, "error", err)
+		h.Logger.ErrorContext(ctx, "Error getting issues in epic", "epicKey", epicKey, "jql", jql, "error", err)
 		respondWithError(w, statusCode, userMessage) // Use user-friendly message
 		return
 	}
+	if resp.Total > 0 || len(resp.Issues) > 0 {
+		h.prepareIssuesResponse(r, resp.Issues)
+		respondWithJSON(w, http.StatusOK, epicIssuesResponse{SearchResponse: resp})
+		return
+	}
 
+	// A wrong epic link field silently matches nothing, so try the parent
+	// relationship before concluding the epic is empty.
+	fallback, err := h.JiraSvc.SearchIssues(ctx, fallbackJQL, startAt, maxResults, fields)
+	if err != nil {
+		// The fallback is best effort; keep the (empty) primary result
+		h.Logger.WarnContext(ctx, "Epic fallback search failed", "epicKey", epicKey, "jql", fallbackJQL, "error", err)
+		fallback = resp
+	}
+
+	var warning string
+	if fallback.Total > 0 || len(fallback.Issues) > 0 {
+		warning = fmt.Sprintf("No issues matched the epic link field %s; results were found using %s instead. The epic link field may be misconfigured.", epicLinkField, fallbackJQL)
+	} else {
+		fallback = resp
+		warning = fmt.Sprintf("No issues found for epic %s. If the epic is not empty, the epic link field (%s) may be misconfigured for this JIRA instance.", epicKey, epicLinkField)
+	}
+	h.Logger.WarnContext(ctx, "Epic search returned no issues via the epic link field", "epicKey", epicKey, "epicLinkField", epicLinkField, "fallbackMatches", fallback.Total)
+
+	h.prepareIssuesResponse(r, fallback.Issues)
+	respondWithJSON(w, http.StatusOK, epicIssuesResponse{SearchResponse: fallback, Warning: warning})
+}
+
+// maxEpicResults caps the maxResults query parameter of GetIssuesInEpicHandler.
+const maxEpicResults = 100
+
+// epicIssuesResponse is the search response returned by GetIssuesInEpicHandler,
+// with an optional warning when the epic link field appears to be misconfigured.
+type epicIssuesResponse struct {
+	*jira.SearchResponse
+	Warning string `json:"warning,omitempty"`
+}
+
+// ChangedIssuesRequest defines the expected JSON structure for the request body
+// of the ChangedIssuesHandler.
+type ChangedIssuesRequest struct {
+	JQL        string   `json:"jql"`
+	Since      string   `json:"since"`   // RFC 3339 timestamp, e.g. "2024-01-01T00:00:00Z"
+	StartAt    int      `json:"startAt"` // Zero-based index of the first result, default 0
+	MaxResults int      `json:"maxResults"`
+	Fields     []string `json:"fields"`
+}
+
+// ChangedIssuesHandler handles POST requests to /jira_changed.
+// It restricts the given JQL to issues updated at or after the "since" timestamp,
+// expands each issue's changelog, and trims the changelog down to the entries
+// created since that timestamp so callers can sync incrementally.
+func (h *JiraHandlers) ChangedIssuesHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req ChangedIssuesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	if req.Since == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing required field: since")
+		return
+	}
+	since, err := time.Parse(time.RFC3339, req.Since)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid 'since' timestamp: expected RFC 3339 format")
+		return
+	}
+	if req.StartAt < 0 {
+		respondWithError(w, http.StatusBadRequest, "Invalid startAt: expected a non-negative integer")
+		return
+	}
+	if h.tooManyFields(req.Fields) {
+		respondWithError(w, http.StatusBadRequest, h.tooManyFieldsMessage())
+		return
+	}
+
+	// JQL reads date literals in the JIRA user's timezone, but takes a number as
+	// epoch milliseconds, which pins the window to the instant regardless of timezone
+	jql := appendJQLClause(req.JQL, "updated >= "+strconv.FormatInt(since.UnixMilli(), 10))
+
+	maxResults := req.MaxResults
+	if maxResults <= 0 {
+		maxResults = h.defaultMaxResults("changed")
+	}
+
+	ctx := r.Context()
+	resp, err := h.JiraSvc.SearchIssuesWithExpand(ctx, jql, req.StartAt, maxResults, req.Fields, []string{"changelog"})
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error searching changed JIRA issues", "jql", jql, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	for i := range resp.Issues {
+		trimChangelogBefore(&resp.Issues[i], since)
+	}
+
+	h.prepareIssuesResponse(r, resp.Issues)
 	respondWithJSON(w, http.StatusOK, resp)
 }
+
+// trimChangelogBefore removes changelog entries created before since from the issue.
+// Entries with unparseable timestamps are kept rather than silently dropped.
+func trimChangelogBefore(issue *jira.Issue, since time.Time) {
+	if issue.Changelog == nil {
+		return
+	}
+	recent := make([]jira.ChangelogEntry, 0, len(issue.Changelog.Histories))
+	for _, entry := range issue.Changelog.Histories {
+		created, err := time.Parse(jira.ChangelogTimeLayout, entry.Created)
+		if err == nil && created.Before(since) {
+			continue
+		}
+		recent = append(recent, entry)
+	}
+	issue.Changelog.Histories = recent
+}
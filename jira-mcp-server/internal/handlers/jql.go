@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// orderByPattern matches a trailing ORDER BY clause in a JQL query (case-insensitive).
+var orderByPattern = regexp.MustCompile(`(?i)\s*\border\s+by\b`)
+
+// splitOrderBy separates a JQL query into its filter part and its ORDER BY clause.
+// The returned orderBy includes the "ORDER BY" keywords, or is empty if the query has none.
+func splitOrderBy(jql string) (filter string, orderBy string) {
+	loc := orderByPattern.FindStringIndex(jql)
+	if loc == nil {
+		return strings.TrimSpace(jql), ""
+	}
+	return strings.TrimSpace(jql[:loc[0]]), strings.TrimSpace(jql[loc[0]:])
+}
+
+// appendJQLClause ANDs clause onto an existing JQL query, keeping any ORDER BY
+// clause at the end where JQL requires it. The original filter is parenthesized
+// so that OR expressions in it do not bind to the new clause.
+func appendJQLClause(jql, clause string) string {
+	filter, orderBy := splitOrderBy(jql)
+
+	var combined string
+	if filter == "" {
+		combined = clause
+	} else {
+		combined = "(" + filter + ") AND " + clause
+	}
+
+	if orderBy != "" {
+		combined += " " + orderBy
+	}
+	return combined
+}
+
+// bareJQLFieldPattern matches field references that can be used in JQL without quoting,
+// such as "created", "customfield_10020" or "cf[10020]".
+var bareJQLFieldPattern = regexp.MustCompile(`^(?:[A-Za-z_][A-Za-z0-9_.]*|cf\[\d+\])$`)
+
+// appendOrderBy adds "ORDER BY field dir" to a JQL query that has no ORDER BY clause yet.
+// Queries that already specify an ordering are returned unchanged. Field names that are
+// not plain identifiers (e.g. "Story Points") are quoted.
+func appendOrderBy(jql, field, dir string) string {
+	if _, orderBy := splitOrderBy(jql); orderBy != "" {
+		return jql
+	}
+	if !bareJQLFieldPattern.MatchString(field) {
+		field = quoteJQLString(field)
+	}
+	return strings.TrimSpace(jql) + " ORDER BY " + field + " " + dir
+}
+
+// quoteJQLString wraps a value in double quotes for use as a JQL string literal,
+// escaping backslashes and embedded double quotes.
+func quoteJQLString(value string) string {
+	escaped := strings.ReplaceAll(value, `\`, `\\`)
+	escaped = strings.ReplaceAll(escaped, `"`, `\"`)
+	return `"` + escaped + `"`
+}
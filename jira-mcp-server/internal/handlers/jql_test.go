@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendOrderBy(t *testing.T) {
+	cases := []struct {
+		name  string
+		jql   string
+		field string
+		dir   string
+		want  string
+	}{
+		{"Plain field", "project = PROJ", "created", "DESC", "project = PROJ ORDER BY created DESC"},
+		{"Custom field", "project = PROJ", "cf[10020]", "ASC", "project = PROJ ORDER BY cf[10020] ASC"},
+		{"Quoted field", "project = PROJ", `Story "Points"`, "ASC", `project = PROJ ORDER BY "Story \"Points\"" ASC`},
+		{"Existing ORDER BY kept", "project = PROJ order by rank", "created", "DESC", "project = PROJ order by rank"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, appendOrderBy(tc.jql, tc.field, tc.dir))
+		})
+	}
+}
+
+func TestAppendJQLClause_HistoryPredicates(t *testing.T) {
+	jql := `status WAS "In Progress" DURING ("2024/01/01", "2024/01/31") OR status CHANGED BY currentUser() ORDER BY updated DESC`
+
+	got := appendJQLClause(jql, `updated >= "2024/02/01 00:00"`)
+
+	assert.Equal(t, `(status WAS "In Progress" DURING ("2024/01/01", "2024/01/31") OR status CHANGED BY currentUser()) AND updated >= "2024/02/01 00:00" ORDER BY updated DESC`, got)
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// UpdateLabelsRequest is the body of POST /jira_issue/{issueKey}/labels.
+type UpdateLabelsRequest struct {
+	Add    []string `json:"add"`
+	Remove []string `json:"remove"`
+}
+
+// UpdateLabelsHandler handles POST requests to /jira_issue/{issueKey}/labels.
+// It adds and removes the given labels without touching the issue's other labels
+// or fields. Returns 204 No Content on success.
+func (h *JiraHandlers) UpdateLabelsHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	var req UpdateLabelsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	if len(req.Add) == 0 && len(req.Remove) == 0 {
+		respondWithError(w, http.StatusBadRequest, "No labels to change: provide add and/or remove")
+		return
+	}
+	// JIRA labels cannot contain spaces; reject them here instead of with JIRA's opaque 400
+	for _, label := range append(append([]string{}, req.Add...), req.Remove...) {
+		if label == "" || strings.ContainsAny(label, " \t\r\n") {
+			respondWithError(w, http.StatusBadRequest, fmt.Sprintf("Invalid label %q: labels must be non-empty and cannot contain spaces", label))
+			return
+		}
+	}
+
+	ctx := r.Context()
+	if err := h.JiraSvc.UpdateLabels(ctx, issueKey, req.Add, req.Remove); err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error updating JIRA issue labels", "issueKey", issueKey, "add", req.Add, "remove", req.Remove, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
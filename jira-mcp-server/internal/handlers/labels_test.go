@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateLabelsHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/jira_issue/PROJ-1/labels", strings.NewReader(body))
+		return mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		rr := httptest.NewRecorder()
+		mockService.On("UpdateLabels", mock.Anything, "PROJ-1", []string{"backend"}, []string{"triage"}).Return(nil)
+
+		NewJiraHandlers(mockService, testLogger).UpdateLabelsHandler(rr, newRequest(`{"add":["backend"],"remove":["triage"]}`))
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	for name, tc := range map[string]struct{ body, message string }{
+		"No Labels":    {`{"add":[]}`, "No labels to change"},
+		"Label Spaces": {`{"add":["needs review"]}`, `Invalid label \"needs review\"`},
+		"Empty Label":  {`{"remove":[""]}`, `Invalid label \"\"`},
+		"Invalid Body": {`{"add":"backend"}`, "Invalid request body"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			rr := httptest.NewRecorder()
+
+			NewJiraHandlers(mockService, testLogger).UpdateLabelsHandler(rr, newRequest(tc.body))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), tc.message)
+			mockService.AssertNotCalled(t, "UpdateLabels", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
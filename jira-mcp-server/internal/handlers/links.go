@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// LinkIssuesRequest is the body of POST /jira_issue_link.
+type LinkIssuesRequest struct {
+	InwardKey  string `json:"inward_key"`
+	OutwardKey string `json:"outward_key"`
+	LinkType   string `json:"link_type"`
+}
+
+// LinkIssuesHandler handles POST requests to /jira_issue_link.
+// It links two issues with a named link type such as "Blocks" or "Relates"; for
+// directional types, outward_key is the issue the link points from
+// ("outward_key blocks inward_key"). Valid names are listed by /jira_issue_link_types.
+func (h *JiraHandlers) LinkIssuesHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req LinkIssuesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	if req.InwardKey == "" || req.OutwardKey == "" || req.LinkType == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing required fields: inward_key, outward_key and link_type")
+		return
+	}
+	if h.NormalizeIssueKeys {
+		req.InwardKey = normalizeIssueKey(req.InwardKey)
+		req.OutwardKey = normalizeIssueKey(req.OutwardKey)
+	}
+	if !ValidIssueKey(req.InwardKey) || !ValidIssueKey(req.OutwardKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.JiraSvc.LinkIssues(ctx, req.InwardKey, req.OutwardKey, req.LinkType); err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error linking JIRA issues", "inwardKey", req.InwardKey, "outwardKey", req.OutwardKey, "linkType", req.LinkType, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, req)
+}
+
+// GetLinkTypesHandler handles GET requests to /jira_issue_link_types.
+// It lists the link types configured in JIRA as [{id, name, inward, outward}].
+func (h *JiraHandlers) GetLinkTypesHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	linkTypes, err := h.JiraSvc.GetLinkTypes(r.Context())
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(r.Context(), "Error listing JIRA issue link types", "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, linkTypes)
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestLinkIssuesHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("LinkIssues", mock.Anything, "PROJ-2", "PROJ-1", "Blocks").Return(nil)
+
+		body := `{"inward_key":"PROJ-2","outward_key":"PROJ-1","link_type":"Blocks"}`
+		rr := httptest.NewRecorder()
+		handlers.LinkIssuesHandler(rr, httptest.NewRequest(http.MethodPost, "/jira_issue_link", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		require.JSONEq(t, body, rr.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	for name, body := range map[string]string{
+		"Missing inward_key":  `{"outward_key":"PROJ-1","link_type":"Blocks"}`,
+		"Missing outward_key": `{"inward_key":"PROJ-2","link_type":"Blocks"}`,
+		"Missing link_type":   `{"inward_key":"PROJ-2","outward_key":"PROJ-1"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			handlers := NewJiraHandlers(mockService, testLogger)
+
+			rr := httptest.NewRecorder()
+			handlers.LinkIssuesHandler(rr, httptest.NewRequest(http.MethodPost, "/jira_issue_link", strings.NewReader(body)))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			require.JSONEq(t, `{"error":"Missing required fields: inward_key, outward_key and link_type"}`, rr.Body.String())
+			mockService.AssertNotCalled(t, "LinkIssues", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("Unknown Link Type", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("LinkIssues", mock.Anything, "PROJ-2", "PROJ-1", "Nope").
+			Return(&jira.JiraAPIError{StatusCode: http.StatusNotFound})
+
+		body := `{"inward_key":"PROJ-2","outward_key":"PROJ-1","link_type":"Nope"}`
+		rr := httptest.NewRecorder()
+		handlers.LinkIssuesHandler(rr, httptest.NewRequest(http.MethodPost, "/jira_issue_link", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestGetLinkTypesHandler(t *testing.T) {
+	mockService := new(mockJiraService)
+	handlers := NewJiraHandlers(mockService, slog.New(slog.NewJSONHandler(io.Discard, nil)))
+	mockService.On("GetLinkTypes", mock.Anything).Return([]jira.LinkType{
+		{ID: "10000", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"},
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	handlers.GetLinkTypesHandler(rr, httptest.NewRequest(http.MethodGet, "/jira_issue_link_types", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `[{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks"}]`, rr.Body.String())
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// markdownContentType is the media type for Markdown responses (RFC 7763).
+const markdownContentType = "text/markdown"
+
+// markdownEscaper escapes characters that would otherwise be interpreted as
+// Markdown syntax or break out of a table cell.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`|`, `\|`,
+	`*`, `\*`,
+	`_`, `\_`,
+	"`", "\\`",
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`>`, `\>`,
+	`#`, `\#`,
+	"\r\n", " ",
+	"\n", " ",
+)
+
+// wantsMarkdown reports whether the client asked for a Markdown response,
+// either via ?format=md or an Accept header containing text/markdown.
+func wantsMarkdown(r *http.Request) bool {
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format == "md" || format == "markdown" {
+		return true
+	}
+	return strings.Contains(r.Header.Get("Accept"), markdownContentType)
+}
+
+// escapeMarkdownCell escapes a value for use inside a Markdown table cell.
+func escapeMarkdownCell(value string) string {
+	return markdownEscaper.Replace(value)
+}
+
+// nestedString returns fields[key][subKey] as a string, or "" if it is absent.
+func nestedString(fields map[string]interface{}, key, subKey string) string {
+	obj, ok := fields[key].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	value, _ := obj[subKey].(string)
+	return value
+}
+
+// renderIssuesMarkdown renders issues as a Markdown table with key (linked to the
+// issue's browse URL), summary, status and assignee columns.
+func renderIssuesMarkdown(issues []jira.Issue) string {
+	var sb strings.Builder
+	sb.WriteString("| Key | Summary | Status | Assignee |\n")
+	sb.WriteString("| --- | --- | --- | --- |\n")
+
+	for i := range issues {
+		issue := &issues[i]
+
+		key := escapeMarkdownCell(issue.Key)
+		if browseURL := issue.BrowseURL(); browseURL != "" {
+			key = fmt.Sprintf("[%s](%s)", key, browseURL)
+		}
+
+		summary, _ := issue.Fields["summary"].(string)
+		assignee := nestedString(issue.Fields, "assignee", "displayName")
+		if assignee == "" {
+			assignee = "Unassigned"
+		}
+
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s |\n",
+			key,
+			escapeMarkdownCell(summary),
+			escapeMarkdownCell(nestedString(issue.Fields, "status", "name")),
+			escapeMarkdownCell(assignee),
+		)
+	}
+	return sb.String()
+}
+
+// respondWithMarkdown writes a Markdown response body.
+func respondWithMarkdown(w http.ResponseWriter, code int, body string) {
+	w.Header().Set("Content-Type", markdownContentType+"; charset=utf-8")
+	w.WriteHeader(code)
+	_, _ = w.Write([]byte(body))
+}
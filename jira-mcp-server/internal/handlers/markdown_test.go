@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestSearchJiraIssuesHandler_Markdown(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	reqBody := `{"jql": "project=PROJ"}`
+	req := httptest.NewRequest(http.MethodPost, "/search_jira_issues?format=md", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	serviceResp := &jira.SearchResponse{
+		Total: 2,
+		Issues: []jira.Issue{
+			{
+				Key:  "PROJ-1",
+				Self: "https://example.atlassian.net/rest/api/3/issue/10001",
+				Fields: map[string]interface{}{
+					"summary":  "Fix a|b *bold* [link]",
+					"status":   map[string]interface{}{"name": "In Progress"},
+					"assignee": map[string]interface{}{"displayName": "Jane Doe"},
+				},
+			},
+			{
+				Key:    "PROJ-2",
+				Self:   "https://example.atlassian.net/rest/api/3/issue/10002",
+				Fields: map[string]interface{}{"summary": "Unowned", "status": map[string]interface{}{"name": "To Do"}, "assignee": nil},
+			},
+		},
+	}
+	mockService.On("SearchIssues", mock.Anything, "project=PROJ", 0, 50, []string(nil)).Return(serviceResp, nil)
+
+	handlers.SearchIssuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.True(t, strings.HasPrefix(rr.Header().Get("Content-Type"), "text/markdown"))
+	lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+	assert.Equal(t, []string{
+		"| Key | Summary | Status | Assignee |",
+		"| --- | --- | --- | --- |",
+		`| [PROJ-1](https://example.atlassian.net/browse/PROJ-1) | Fix a\|b \*bold\* \[link\] | In Progress | Jane Doe |`,
+		"| [PROJ-2](https://example.atlassian.net/browse/PROJ-2) | Unowned | To Do | Unassigned |",
+	}, lines)
+	mockService.AssertExpectations(t)
+}
+
+func TestWantsMarkdown(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/search_jira_issues", nil)
+	assert.False(t, wantsMarkdown(req), "JSON should remain the default")
+
+	req.Header.Set("Accept", "text/markdown")
+	assert.True(t, wantsMarkdown(req))
+
+	req = httptest.NewRequest(http.MethodPost, "/search_jira_issues?format=md", nil)
+	assert.True(t, wantsMarkdown(req))
+}
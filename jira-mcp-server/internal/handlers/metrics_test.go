@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	metrics := NewMetrics()
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(metrics))
+
+	router := mux.NewRouter()
+	router.Use(metrics.Middleware)
+	router.Use(ProblemDetailsMiddleware)
+	router.HandleFunc("/jira_issue/{issueKey}", func(w http.ResponseWriter, r *http.Request) {
+		if mux.Vars(r)["issueKey"] == "MISSING-1" {
+			respondWithError(w, http.StatusNotFound, "JIRA resource not found.")
+			return
+		}
+		respondWithJSON(w, http.StatusOK, map[string]string{"key": "PROJ-1"})
+	}).Methods("GET").Name("get")
+	router.HandleFunc("/unnamed", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	for _, path := range []string{"/jira_issue/PROJ-1", "/jira_issue/PROJ-1", "/jira_issue/MISSING-1", "/unnamed"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept", problemJSONType)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.requests.WithLabelValues("get", "GET", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues("get", "GET", "404")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues("unnamed", "GET", "200")))
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.duration), "One histogram per route")
+}
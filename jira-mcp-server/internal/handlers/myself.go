@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+)
+
+// GetCurrentUserHandler handles GET requests to /jira_myself.
+// It returns the JIRA user the server authenticates as, which helps diagnose
+// credentials that belong to an unexpected account.
+func (h *JiraHandlers) GetCurrentUserHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	user, err := h.JiraSvc.GetCurrentUser(r.Context())
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(r.Context(), "Error fetching the current JIRA user", "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, user)
+}
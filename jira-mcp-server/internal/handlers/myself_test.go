@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestGetCurrentUserHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("GetCurrentUser", mock.Anything).Return(&jira.User{
+			AccountID: "abc-123", DisplayName: "JIRA Bot", EmailAddress: "bot@example.com", Active: true,
+		}, nil)
+
+		rr := httptest.NewRecorder()
+		handlers.GetCurrentUserHandler(rr, httptest.NewRequest(http.MethodGet, "/jira_myself", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `{"accountId":"abc-123","displayName":"JIRA Bot","emailAddress":"bot@example.com","active":true}`, rr.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("GetCurrentUser", mock.Anything).Return(nil, &jira.JiraAPIError{StatusCode: http.StatusUnauthorized})
+
+		rr := httptest.NewRecorder()
+		handlers.GetCurrentUserHandler(rr, httptest.NewRequest(http.MethodGet, "/jira_myself", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		require.JSONEq(t, `{"error":"Authentication failed with JIRA."}`, rr.Body.String())
+	})
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// relevantPermissions are the permissions the server's endpoints rely on.
+var relevantPermissions = []string{
+	jira.PermissionCreateIssues,
+	jira.PermissionEditIssues,
+	jira.PermissionTransitionIssues,
+	jira.PermissionAddComments,
+}
+
+// GetPermissionsHandler handles GET requests to /jira_permissions.
+// It validates the configured credentials and reports which of the relevant
+// permissions the account has, optionally in the project given by ?project=KEY.
+// The response looks like {"project": "PROJ", "permissions": {"CREATE_ISSUES": true, ...}}.
+func (h *JiraHandlers) GetPermissionsHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	projectKey := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("project")))
+
+	ctx := r.Context()
+	perms, err := h.JiraSvc.GetMyPermissions(ctx, projectKey, relevantPermissions)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error getting JIRA permissions", "project", projectKey, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	flags := make(map[string]bool, len(relevantPermissions))
+	for _, key := range relevantPermissions {
+		flags[key] = perms[key].HavePermission
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{
+		"project":     projectKey,
+		"permissions": flags,
+	})
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestGetPermissionsHandler_Success(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_permissions?project=proj", nil)
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetMyPermissions", mock.Anything, "PROJ", relevantPermissions).Return(map[string]jira.Permission{
+		jira.PermissionCreateIssues:     {Key: jira.PermissionCreateIssues, HavePermission: true},
+		jira.PermissionEditIssues:       {Key: jira.PermissionEditIssues, HavePermission: true},
+		jira.PermissionTransitionIssues: {Key: jira.PermissionTransitionIssues, HavePermission: false},
+	}, nil)
+
+	handlers.GetPermissionsHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{"project":"PROJ","permissions":{"CREATE_ISSUES":true,"EDIT_ISSUES":true,"TRANSITION_ISSUES":false,"ADD_COMMENTS":false}}`, rr.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestGetPermissionsHandler_InvalidCredentials(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_permissions", nil)
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetMyPermissions", mock.Anything, "", relevantPermissions).Return(nil, &jira.JiraAPIError{StatusCode: http.StatusUnauthorized})
+
+	handlers.GetPermissionsHandler(rr, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	require.JSONEq(t, `{"error":"Authentication failed with JIRA."}`, rr.Body.String())
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// problemJSONType is the media type of RFC 7807 problem details.
+const problemJSONType = "application/problem+json"
+
+// ProblemDetails is an RFC 7807 error body, sent instead of {"error": ...} to clients
+// that ask for application/problem+json.
+type ProblemDetails struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	errorExtensions
+}
+
+// errorExtensions are members some errors carry next to their message, so that the
+// caller can act on them: in the default {"error": ...} body and, for problem details,
+// as extension members.
+type errorExtensions struct {
+	FieldErrors map[string]string `json:"field_errors,omitempty"` // JIRA's errors per rejected field
+	Candidates  []jira.User       `json:"candidates,omitempty"`   // Users an ambiguous email matched
+}
+
+// problemResponseWriter marks the response of a request whose client accepts problem
+// details, so that respondWithError can pick the format without access to the request.
+type problemResponseWriter struct {
+	http.ResponseWriter
+	instance string // The request path, reported as the problem instance
+}
+
+// Unwrap lets http.ResponseController reach the underlying ResponseWriter.
+func (w *problemResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// ProblemDetailsMiddleware makes error responses use the RFC 7807 format when the
+// request's Accept header lists application/problem+json. Other clients keep getting
+// the default {"error": ...} body.
+func ProblemDetailsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if acceptsProblemJSON(r.Header.Get("Accept")) {
+			w = &problemResponseWriter{ResponseWriter: w, instance: r.URL.Path}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// acceptsProblemJSON reports whether an Accept header includes application/problem+json
+// with a non-zero quality. Wildcards do not count, so that clients sending */* keep the
+// default format.
+func acceptsProblemJSON(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || mediaType != problemJSONType {
+			continue
+		}
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil && q == 0 {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// respondWithProblem writes message as RFC 7807 problem details. JIRA errors have no
+// dedicated problem type URIs, so the type is "about:blank" and the title is the
+// standard text of the status code.
+func respondWithProblem(w *problemResponseWriter, code int, message string) {
+	writeJSON(w, code, problemJSONType, ProblemDetails{
+		Type:     "about:blank",
+		Title:    http.StatusText(code),
+		Status:   code,
+		Detail:   message,
+		Instance: w.instance,
+	})
+}
+
+// respondWithErrorExtensions is respondWithError for errors that carry extensions.
+func respondWithErrorExtensions(w http.ResponseWriter, code int, message string, ext errorExtensions) {
+	if pw, ok := w.(*problemResponseWriter); ok {
+		writeJSON(pw, code, problemJSONType, ProblemDetails{
+			Type:            "about:blank",
+			Title:           http.StatusText(code),
+			Status:          code,
+			Detail:          message,
+			Instance:        pw.instance,
+			errorExtensions: ext,
+		})
+		return
+	}
+	respondWithJSON(w, code, struct {
+		Error string `json:"error"`
+		errorExtensions
+	}{message, ext})
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestProblemDetailsMiddleware_NotFound(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	mockService.On("GetIssue", mock.Anything, "PROJ-404", []string(nil)).Return(nil, &jira.JiraAPIError{StatusCode: http.StatusNotFound})
+
+	r := mux.NewRouter()
+	r.Use(ProblemDetailsMiddleware)
+	r.HandleFunc("/jira_issue/{issueKey}", handlers.GetIssueDetailsHandler).Methods("GET")
+
+	t.Run("Problem JSON", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-404", nil)
+		req.Header.Set("Accept", "application/json, application/problem+json;q=0.9")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+		require.JSONEq(t, `{
+			"type": "about:blank",
+			"title": "Not Found",
+			"status": 404,
+			"detail": "JIRA resource not found.",
+			"instance": "/jira_issue/PROJ-404"
+		}`, rr.Body.String())
+	})
+
+	t.Run("Default Format", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-404", nil)
+		req.Header.Set("Accept", "*/*")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+		require.JSONEq(t, `{"error":"JIRA resource not found."}`, rr.Body.String())
+	})
+}
+
+func TestAcceptsProblemJSON(t *testing.T) {
+	cases := map[string]bool{
+		"":                         false,
+		"application/json":         false,
+		"*/*":                      false,
+		"application/problem+json": true,
+		"application/json, application/problem+json": true,
+		"application/problem+json;q=0":               false,
+		"Application/Problem+JSON; charset=utf-8":    true,
+	}
+	for accept, want := range cases {
+		assert.Equal(t, want, acceptsProblemJSON(accept), "Accept: %q", accept)
+	}
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+)
+
+// GetProjectsHandler handles GET requests to /jira_projects.
+// It lists every project visible to the configured account as [{id, key, name, projectTypeKey}].
+func (h *JiraHandlers) GetProjectsHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	projects, err := h.JiraSvc.GetProjects(r.Context())
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(r.Context(), "Error listing JIRA projects", "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, projects)
+}
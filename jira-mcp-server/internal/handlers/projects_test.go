@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestGetProjectsHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("GetProjects", mock.Anything).Return([]jira.Project{
+			{ID: "10000", Key: "PROJ", Name: "Project", ProjectTypeKey: "software"},
+		}, nil)
+
+		rr := httptest.NewRecorder()
+		handlers.GetProjectsHandler(rr, httptest.NewRequest(http.MethodGet, "/jira_projects", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `[{"id":"10000","key":"PROJ","name":"Project","projectTypeKey":"software"}]`, rr.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("JIRA Error", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		handlers := NewJiraHandlers(mockService, testLogger)
+		mockService.On("GetProjects", mock.Anything).Return(nil, &jira.JiraAPIError{StatusCode: http.StatusUnauthorized})
+
+		rr := httptest.NewRecorder()
+		handlers.GetProjectsHandler(rr, httptest.NewRequest(http.MethodGet, "/jira_projects", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		require.JSONEq(t, `{"error":"Authentication failed with JIRA."}`, rr.Body.String())
+	})
+}
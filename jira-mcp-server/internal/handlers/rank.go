@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// RankIssuesHandler handles PUT requests to /jira_rank.
+// It accepts {"issues": ["PROJ-2", ...], "rankBeforeIssue": "PROJ-1"} or the same
+// with "rankAfterIssue", and responds with 204 No Content on success.
+func (h *JiraHandlers) RankIssuesHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPut {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req jira.RankIssuesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	if len(req.Issues) == 0 {
+		respondWithError(w, http.StatusBadRequest, "Missing required field: issues")
+		return
+	}
+	if len(req.Issues) > jira.MaxRankIssues {
+		respondWithError(w, http.StatusBadRequest, fmt.Sprintf("Too many issues: at most %d issues can be ranked at once", jira.MaxRankIssues))
+		return
+	}
+	if (req.RankBeforeIssue == "") == (req.RankAfterIssue == "") {
+		respondWithError(w, http.StatusBadRequest, "Exactly one of rankBeforeIssue or rankAfterIssue must be set")
+		return
+	}
+
+	if h.NormalizeIssueKeys {
+		for i, key := range req.Issues {
+			req.Issues[i] = normalizeIssueKey(key)
+		}
+		if req.RankBeforeIssue != "" {
+			req.RankBeforeIssue = normalizeIssueKey(req.RankBeforeIssue)
+		}
+		if req.RankAfterIssue != "" {
+			req.RankAfterIssue = normalizeIssueKey(req.RankAfterIssue)
+		}
+	}
+	for _, key := range append([]string{req.RankBeforeIssue, req.RankAfterIssue}, req.Issues...) {
+		if key != "" && !ValidIssueKey(key) {
+			respondWithError(w, http.StatusBadRequest, fmt.Sprintf("%s (got %q)", invalidIssueKeyMessage, key))
+			return
+		}
+	}
+
+	ctx := r.Context()
+	if err := h.JiraSvc.RankIssues(ctx, req); err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error ranking JIRA issues", "issues", req.Issues, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
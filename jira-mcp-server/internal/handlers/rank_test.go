@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestRankIssuesHandler_Success(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	handlers.NormalizeIssueKeys = true
+
+	reqBody := `{"issues": ["proj-2", "PROJ-3"], "rankAfterIssue": "proj-1"}`
+	req := httptest.NewRequest(http.MethodPut, "/jira_rank", strings.NewReader(reqBody))
+	rr := httptest.NewRecorder()
+
+	expectedReq := jira.RankIssuesRequest{Issues: []string{"PROJ-2", "PROJ-3"}, RankAfterIssue: "PROJ-1"}
+	mockService.On("RankIssues", mock.Anything, expectedReq).Return(nil)
+
+	handlers.RankIssuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestRankIssuesHandler_BadRequest_Anchor(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	for _, body := range []string{
+		`{"issues": ["PROJ-2"]}`,
+		`{"issues": ["PROJ-2"], "rankBeforeIssue": "PROJ-1", "rankAfterIssue": "PROJ-3"}`,
+	} {
+		req := httptest.NewRequest(http.MethodPut, "/jira_rank", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		handlers.RankIssuesHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		require.JSONEq(t, `{"error":"Exactly one of rankBeforeIssue or rankAfterIssue must be set"}`, rr.Body.String())
+	}
+	mockService.AssertNotCalled(t, "RankIssues", mock.Anything, mock.Anything)
+}
+
+func TestRankIssuesHandler_BadRequest_InvalidKey(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	for _, body := range []string{
+		`{"issues": ["PROJ-2", "oops"], "rankBeforeIssue": "PROJ-1"}`,
+		`{"issues": ["PROJ-2"], "rankAfterIssue": "proj-1"}`,
+	} {
+		req := httptest.NewRequest(http.MethodPut, "/jira_rank", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		handlers.RankIssuesHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Invalid issue key format")
+	}
+	mockService.AssertNotCalled(t, "RankIssues", mock.Anything, mock.Anything)
+}
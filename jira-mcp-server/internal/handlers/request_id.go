@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+)
+
+// RequestIDHeader carries the request ID in requests and responses.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds client-supplied request IDs so they cannot bloat the logs.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestIDMiddleware assigns every request an ID, taken from the X-Request-ID
+// header if the client sent one and generated otherwise. The ID is echoed in the
+// response header and stored in the request context for logging.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" || len(id) > maxRequestIDLength {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id)))
+	})
+}
+
+// requestIDFromContext returns the ID assigned by RequestIDMiddleware, or "" if none.
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// RequestIDLogHandler wraps a slog.Handler and adds the request ID stored in the
+// context by RequestIDMiddleware to every record logged with a *Context method, as
+// "request_id". Handlers and the JIRA client log with the request's context, so a
+// request's log lines, including those of the JIRA calls it made, share one ID.
+type RequestIDLogHandler struct {
+	slog.Handler
+}
+
+// NewRequestIDLogHandler returns a RequestIDLogHandler wrapping next.
+func NewRequestIDLogHandler(next slog.Handler) *RequestIDLogHandler {
+	return &RequestIDLogHandler{Handler: next}
+}
+
+// Handle adds the context's request ID, if any, and passes the record on.
+func (h *RequestIDLogHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := requestIDFromContext(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs keeps the wrapper around the derived handler.
+func (h *RequestIDLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &RequestIDLogHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup keeps the wrapper around the derived handler.
+func (h *RequestIDLogHandler) WithGroup(name string) slog.Handler {
+	return &RequestIDLogHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// newRequestID returns a random 16-byte hex ID.
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
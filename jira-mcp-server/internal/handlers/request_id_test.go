@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var seen string
+	handler := RequestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = requestIDFromContext(r.Context())
+	}))
+
+	t.Run("Client Supplied", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(RequestIDHeader, "agent-run-42")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, "agent-run-42", seen)
+		assert.Equal(t, "agent-run-42", rr.Header().Get(RequestIDHeader))
+	})
+
+	t.Run("Generated", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Len(t, seen, 32)
+		assert.Equal(t, seen, rr.Header().Get(RequestIDHeader))
+	})
+}
+
+func TestCreateJiraIssueHandler_LogsRequestCorrelation(t *testing.T) {
+	var logs bytes.Buffer
+	mockService := new(mockJiraService)
+	handlers := NewJiraHandlers(mockService, slog.New(NewRequestIDLogHandler(slog.NewJSONHandler(&logs, nil))))
+
+	mockService.On("CreateIssue", mock.Anything, mock.AnythingOfType("jira.CreateIssueRequest")).
+		Return(&jira.CreateIssueResponse{ID: "10001", Key: "PROJ-123", Self: "https://example.atlassian.net/rest/api/3/issue/10001"}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/create_jira_issue", strings.NewReader(`{"project_key":"PROJ","summary":"S","issue_type":"Task"}`))
+	req.Header.Set(RequestIDHeader, "agent-run-42")
+	rr := httptest.NewRecorder()
+
+	RequestIDMiddleware(http.HandlerFunc(handlers.CreateJiraIssueHandler)).ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var found bool
+	dec := json.NewDecoder(&logs)
+	for {
+		var entry map[string]interface{}
+		if err := dec.Decode(&entry); err == io.EOF {
+			break
+		} else {
+			require.NoError(t, err)
+		}
+		assert.Equal(t, "agent-run-42", entry["request_id"], "every log line of the request should carry its ID: %v", entry["msg"])
+		if entry["msg"] != "JIRA issue created" {
+			continue
+		}
+		found = true
+		assert.Equal(t, "INFO", entry["level"])
+		assert.Equal(t, "agent-run-42", entry["request_id"])
+		assert.Equal(t, "PROJ-123", entry["issue_key"])
+		assert.Equal(t, "10001", entry["issue_id"])
+		assert.Equal(t, "PROJ", entry["project"])
+	}
+	assert.True(t, found, "expected a correlation log line for the created issue")
+}
+
+func TestRequestIDLogHandler(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(NewRequestIDLogHandler(slog.NewJSONHandler(&logs, nil))).With("component", "jira")
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "req-7")
+	logger.WarnContext(ctx, "JIRA rate limit hit; retrying")
+	logger.Warn("No request in scope")
+
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"request_id":"req-7"`)
+	assert.Contains(t, lines[0], `"component":"jira"`, "attributes added with With must be kept")
+	assert.NotContains(t, lines[1], "request_id")
+}
+
+func TestRequestID_ReachesJiraClientLogs(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(NewRequestIDLogHandler(slog.NewJSONHandler(&logs, nil)))
+
+	// JIRA answers the first call with 429, so the client logs a retry
+	calls := 0
+	jiraServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"key":"PROJ-1","fields":{}}`))
+	}))
+	defer jiraServer.Close()
+	client, err := jira.NewClient(jira.Config{BaseURL: jiraServer.URL, UserEmail: "bot@example.com", APIToken: "token", MaxRetries: 1, Logger: logger}, jiraServer.Client())
+	require.NoError(t, err)
+
+	handlers := NewJiraHandlers(client, logger)
+	router := mux.NewRouter()
+	router.Use(RequestIDMiddleware)
+	router.HandleFunc("/jira_issue/{issueKey}", handlers.GetIssueDetailsHandler).Methods("GET")
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1", nil)
+	req.Header.Set(RequestIDHeader, "agent-run-43")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, logs.String(), `"msg":"JIRA rate limit hit; retrying"`)
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		assert.Contains(t, line, `"request_id":"agent-run-43"`)
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// prepareIssuesResponse applies prepareIssueResponse to every issue in the slice.
+func (h *JiraHandlers) prepareIssuesResponse(r *http.Request, issues []jira.Issue) {
+	for i := range issues {
+		h.prepareIssueResponse(r, &issues[i])
+	}
+}
+
+// prepareIssueResponse applies the response settings to an issue fetched from
+// JIRA just before it is returned: OmitSelf, ResponseFieldAliases and the
+// request's ?omitEmpty=true option.
+func (h *JiraHandlers) prepareIssueResponse(r *http.Request, issue *jira.Issue) {
+	h.omitSelfFromIssue(issue)
+	if issue != nil && r.URL.Query().Get("omitEmpty") == "true" {
+		omitEmptyFields(issue.Fields)
+	}
+	h.aliasIssueFields(issue)
+}
+
+// aliasIssueFields renames the issue's field keys according to ResponseFieldAliases.
+// A field whose alias is already present as a field of its own is left alone.
+func (h *JiraHandlers) aliasIssueFields(issue *jira.Issue) {
+	if len(h.ResponseFieldAliases) == 0 || issue == nil {
+		return
+	}
+	for field, alias := range h.ResponseFieldAliases {
+		value, ok := issue.Fields[field]
+		if !ok {
+			continue
+		}
+		if _, taken := issue.Fields[alias]; taken {
+			continue
+		}
+		issue.Fields[alias] = value
+		delete(issue.Fields, field)
+	}
+}
+
+// omitEmptyFields recursively deletes null values and empty objects and arrays
+// from decoded JSON objects, including those left empty by the deletion itself.
+// False, zero and empty strings are kept.
+func omitEmptyFields(fields map[string]interface{}) {
+	for key, value := range fields {
+		if value = pruneEmpty(value); isEmptyJSON(value) {
+			delete(fields, key)
+		} else {
+			fields[key] = value
+		}
+	}
+}
+
+// pruneEmpty removes empty values nested in value and returns the result.
+func pruneEmpty(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		omitEmptyFields(v)
+		return v
+	case []interface{}:
+		kept := v[:0]
+		for _, item := range v {
+			if item = pruneEmpty(item); !isEmptyJSON(item) {
+				kept = append(kept, item)
+			}
+		}
+		return kept
+	}
+	return value
+}
+
+// isEmptyJSON reports whether value is null, {} or [].
+func isEmptyJSON(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestGetIssueDetailsHandler_ResponseFieldAliases(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	handlers.ResponseFieldAliases = map[string]string{"customfield_10020": "story_points"}
+
+	mockService.On("GetIssue", mock.Anything, "PROJ-1", []string(nil)).Return(&jira.Issue{
+		Key:    "PROJ-1",
+		Fields: map[string]interface{}{"summary": "Estimate me", "customfield_10020": 5},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.GetIssueDetailsHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"story_points":5`)
+	assert.NotContains(t, rr.Body.String(), "customfield_10020")
+}
+
+func TestSearchJiraIssuesHandler_ResponseFieldAliases(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	handlers.ResponseFieldAliases = map[string]string{"customfield_10020": "story_points"}
+
+	mockService.On("SearchIssues", mock.Anything, "project = PROJ", 0, 50, []string(nil)).Return(&jira.SearchResponse{
+		Total: 1,
+		Issues: []jira.Issue{{
+			Key: "PROJ-1",
+			// An existing field with the alias name wins; the original key is then kept
+			Fields: map[string]interface{}{"customfield_10020": 5, "story_points": "native"},
+		}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/search_jira_issues", strings.NewReader(`{"jql":"project = PROJ"}`))
+	rr := httptest.NewRecorder()
+
+	handlers.SearchIssuesHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"story_points":"native"`)
+	assert.Contains(t, rr.Body.String(), `"customfield_10020":5`)
+}
+
+func TestGetIssueDetailsHandler_OmitEmpty(t *testing.T) {
+	issue := func() *jira.Issue {
+		return &jira.Issue{Key: "PROJ-1", Fields: map[string]interface{}{
+			"summary":     "Sparse",
+			"assignee":    nil,
+			"labels":      []interface{}{},
+			"components":  []interface{}{nil, map[string]interface{}{}},
+			"timetrack":   map[string]interface{}{"originalEstimate": nil},
+			"flagged":     false,
+			"storyPoints": 0,
+			"votes":       map[string]interface{}{"votes": 0, "hasVoted": false, "voters": []interface{}{}},
+		}}
+	}
+
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	mockService.On("GetIssue", mock.Anything, "PROJ-1", []string(nil)).Return(issue(), nil).Once()
+	mockService.On("GetIssue", mock.Anything, "PROJ-1", []string(nil)).Return(issue(), nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1?omitEmpty=true", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.GetIssueDetailsHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{"expand":"","id":"","key":"PROJ-1","fields":{
+		"summary":"Sparse",
+		"flagged":false,
+		"storyPoints":0,
+		"votes":{"votes":0,"hasVoted":false}
+	}}`, rr.Body.String())
+
+	// Full output by default
+	req = httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr = httptest.NewRecorder()
+
+	handlers.GetIssueDetailsHandler(rr, req)
+
+	assert.Contains(t, rr.Body.String(), `"assignee":null`)
+	assert.Contains(t, rr.Body.String(), `"labels":[]`)
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import "jira-mcp-server/internal/jira"
+
+// omitSelfFromIssue removes the REST "self" URL from the issue and from any
+// objects nested in its fields (status, assignee, ...) when OmitSelf is enabled.
+func (h *JiraHandlers) omitSelfFromIssue(issue *jira.Issue) {
+	if !h.OmitSelf || issue == nil {
+		return
+	}
+	issue.Self = ""
+	for _, value := range issue.Fields {
+		deleteSelfKeys(value)
+	}
+}
+
+// deleteSelfKeys recursively deletes "self" keys from decoded JSON objects.
+func deleteSelfKeys(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		delete(v, "self")
+		for _, nested := range v {
+			deleteSelfKeys(nested)
+		}
+	case []interface{}:
+		for _, nested := range v {
+			deleteSelfKeys(nested)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func selfTestIssue() *jira.Issue {
+	return &jira.Issue{
+		Key:  "PROJ-1",
+		Self: "https://example.atlassian.net/rest/api/3/issue/10001",
+		Fields: map[string]interface{}{
+			"summary": "Leaky",
+			"status":  map[string]interface{}{"name": "Done", "self": "https://example.atlassian.net/rest/api/3/status/10001"},
+		},
+	}
+}
+
+func TestGetIssueDetailsHandler_OmitSelf(t *testing.T) {
+	for _, omit := range []bool{false, true} {
+		mockService := new(mockJiraService)
+		testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+		handlers := NewJiraHandlers(mockService, testLogger)
+		handlers.OmitSelf = omit
+
+		req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1", nil)
+		req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+		rr := httptest.NewRecorder()
+
+		mockService.On("GetIssue", mock.Anything, "PROJ-1", []string(nil)).Return(selfTestIssue(), nil)
+
+		handlers.GetIssueDetailsHandler(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		if omit {
+			assert.NotContains(t, rr.Body.String(), `"self"`)
+			assert.Contains(t, rr.Body.String(), `"status":{"name":"Done"}`)
+		} else {
+			assert.Contains(t, rr.Body.String(), `"self":"https://example.atlassian.net/rest/api/3/issue/10001"`)
+			assert.Contains(t, rr.Body.String(), `"self":"https://example.atlassian.net/rest/api/3/status/10001"`)
+		}
+	}
+}
+
+func TestCreateJiraIssueHandler_OmitSelf(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	handlers.OmitSelf = true
+	handlers.CreateIncludeMessage = false
+
+	req := httptest.NewRequest(http.MethodPost, "/create_jira_issue", strings.NewReader(`{"project_key": "PROJ", "summary": "S", "issue_type": "Task"}`))
+	rr := httptest.NewRecorder()
+
+	mockService.On("CreateIssue", mock.Anything, mock.AnythingOfType("jira.CreateIssueRequest")).Return(&jira.CreateIssueResponse{ID: "10001", Key: "PROJ-1", Self: "https://example.atlassian.net/rest/api/3/issue/10001"}, nil)
+
+	handlers.CreateJiraIssueHandler(rr, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	require.JSONEq(t, `{"id":"10001","key":"PROJ-1","browseUrl":"https://example.atlassian.net/browse/PROJ-1"}`, rr.Body.String())
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// defaultSubtaskIssueType is the issue type used by CreateSubtaskHandler when none is given.
+const defaultSubtaskIssueType = "Subtask"
+
+// CreateSubtaskRequest defines the expected JSON structure for the request body
+// of the CreateSubtaskHandler.
+type CreateSubtaskRequest struct {
+	Summary     string `json:"summary"`
+	Description string `json:"description,omitempty"`
+	IssueType   string `json:"issue_type,omitempty"` // Defaults to "Subtask"
+	RelatesTo   string `json:"relates_to,omitempty"` // Optional issue to add a "relates to" link to
+}
+
+// CreateSubtaskHandler handles POST requests to /jira_issue/{parentKey}/subtasks.
+// It creates a subtask of the parent in the parent's project and, if relates_to is
+// set, links it to that issue. A failed link does not undo the create; it is
+// reported as "linkError" in the 201 response instead.
+func (h *JiraHandlers) CreateSubtaskHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	parentKey := h.issueKeyVar(r, "parentKey")
+	if parentKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing parent issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(parentKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+	// The subtask is created in the parent's project, which only a key (not an ID) names
+	dash := strings.LastIndex(parentKey, "-")
+	if dash <= 0 {
+		respondWithError(w, http.StatusBadRequest, "Parent must be given as an issue key like PROJ-123, not an issue ID")
+		return
+	}
+
+	var req CreateSubtaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	if req.Summary == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing required field: summary")
+		return
+	}
+	if h.descriptionTooLong(req.Description) {
+		respondWithError(w, http.StatusBadRequest, fmt.Sprintf("Description exceeds maximum length of %d characters", h.MaxDescriptionLength))
+		return
+	}
+	if err := h.checkCreateProject(parentKey[:dash]); err != nil {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if req.IssueType == "" {
+		req.IssueType = defaultSubtaskIssueType
+	}
+	if h.NormalizeIssueKeys && req.RelatesTo != "" {
+		req.RelatesTo = normalizeIssueKey(req.RelatesTo)
+	}
+
+	ctx := r.Context()
+	created, err := h.JiraSvc.CreateIssue(ctx, jira.CreateIssueRequest{
+		ProjectKey:  parentKey[:dash],
+		Summary:     req.Summary,
+		IssueType:   req.IssueType,
+		Description: req.Description,
+		ParentKey:   parentKey,
+	})
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error creating JIRA subtask", "parentKey", parentKey, "error", err)
+		if fieldErrors, ok := createFieldErrors(err); ok {
+			respondWithErrorExtensions(w, statusCode, createFieldErrorsMessage, errorExtensions{FieldErrors: fieldErrors})
+			return
+		}
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	body := map[string]string{
+		"id":        created.ID,
+		"key":       created.Key,
+		"browseUrl": created.BrowseURL(),
+	}
+	if !h.OmitSelf {
+		body["url"] = created.Self
+	}
+	if req.RelatesTo != "" {
+		if err := h.JiraSvc.LinkIssues(ctx, created.Key, req.RelatesTo, jira.LinkTypeRelates); err != nil {
+			_, userMessage := mapJiraError(err)
+			h.Logger.ErrorContext(ctx, "Subtask created but linking failed", "key", created.Key, "relatesTo", req.RelatesTo, "error", err)
+			body["linkError"] = userMessage
+		}
+	}
+
+	respondWithJSON(w, http.StatusCreated, body)
+}
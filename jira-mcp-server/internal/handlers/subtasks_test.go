@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func newSubtaskRequest(parentKey, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/jira_issue/"+url.PathEscape(parentKey)+"/subtasks", strings.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"parentKey": parentKey})
+}
+
+func TestCreateSubtaskHandler_WithLink(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	expectedReq := jira.CreateIssueRequest{
+		ProjectKey: "PROJ",
+		Summary:    "Write tests",
+		IssueType:  "Subtask",
+		ParentKey:  "PROJ-1",
+	}
+	mockService.On("CreateIssue", mock.Anything, expectedReq).Return(&jira.CreateIssueResponse{ID: "10002", Key: "PROJ-2", Self: "https://example.atlassian.net/rest/api/3/issue/10002"}, nil)
+	mockService.On("LinkIssues", mock.Anything, "PROJ-2", "PROJ-7", jira.LinkTypeRelates).Return(nil)
+
+	rr := httptest.NewRecorder()
+	handlers.CreateSubtaskHandler(rr, newSubtaskRequest("PROJ-1", `{"summary": "Write tests", "relates_to": "PROJ-7"}`))
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	require.JSONEq(t, `{"id":"10002","key":"PROJ-2","url":"https://example.atlassian.net/rest/api/3/issue/10002","browseUrl":"https://example.atlassian.net/browse/PROJ-2"}`, rr.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateSubtaskHandler_LinkFailureReported(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	mockService.On("CreateIssue", mock.Anything, mock.AnythingOfType("jira.CreateIssueRequest")).Return(&jira.CreateIssueResponse{Key: "PROJ-2"}, nil)
+	mockService.On("LinkIssues", mock.Anything, "PROJ-2", "PROJ-404", jira.LinkTypeRelates).Return(&jira.JiraAPIError{StatusCode: http.StatusNotFound})
+
+	rr := httptest.NewRecorder()
+	handlers.CreateSubtaskHandler(rr, newSubtaskRequest("PROJ-1", `{"summary": "Write tests", "relates_to": "PROJ-404"}`))
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"key":"PROJ-2"`)
+	assert.Contains(t, rr.Body.String(), `"linkError":"JIRA resource not found."`)
+}
+
+func TestCreateSubtaskHandler_CreateFailureSkipsLink(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	mockService.On("CreateIssue", mock.Anything, mock.AnythingOfType("jira.CreateIssueRequest")).Return(nil, errors.New("boom"))
+
+	rr := httptest.NewRecorder()
+	handlers.CreateSubtaskHandler(rr, newSubtaskRequest("PROJ-1", `{"summary": "Write tests", "issue_type": "Sub-task"}`))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	mockService.AssertCalled(t, "CreateIssue", mock.Anything, mock.MatchedBy(func(req jira.CreateIssueRequest) bool {
+		return req.IssueType == "Sub-task" && req.ParentKey == "PROJ-1"
+	}))
+	mockService.AssertNotCalled(t, "LinkIssues", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateSubtaskHandler_InvalidParentKey(t *testing.T) {
+	for _, parentKey := range []string{"proj-1", "A B-1", "X-abc", "10001"} {
+		t.Run(parentKey, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+			handlers := NewJiraHandlers(mockService, testLogger)
+
+			rr := httptest.NewRecorder()
+			handlers.CreateSubtaskHandler(rr, newSubtaskRequest(parentKey, `{"summary": "Write tests"}`))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			mockService.AssertNotCalled(t, "CreateIssue", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreateSubtaskHandler_LowercaseParentNormalized(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	handlers.NormalizeIssueKeys = true
+
+	mockService.On("CreateIssue", mock.Anything, mock.MatchedBy(func(req jira.CreateIssueRequest) bool {
+		return req.ProjectKey == "PROJ" && req.ParentKey == "PROJ-1"
+	})).Return(&jira.CreateIssueResponse{Key: "PROJ-2"}, nil)
+
+	rr := httptest.NewRecorder()
+	handlers.CreateSubtaskHandler(rr, newSubtaskRequest("proj-1", `{"summary": "Write tests"}`))
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// TimeoutMiddleware bounds the time a request may spend waiting on JIRA. The timeout
+// is looked up in RouteTimeouts by the name of the matched mux route, falling back to
+// RequestTimeout. Handlers report an expired deadline as 504 Gateway Timeout.
+// A timeout of zero disables the limit.
+func (h *JiraHandlers) TimeoutMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timeout := h.RequestTimeout
+		if route := mux.CurrentRoute(r); route != nil {
+			if d, ok := h.RouteTimeouts[route.GetName()]; ok {
+				timeout = d
+			}
+		}
+		if timeout <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestTimeoutMiddleware_PerRouteTimeouts(t *testing.T) {
+	// A slow JIRA that takes 100ms to answer anything
+	jiraServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		if r.URL.Path == "/rest/api/3/search" {
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":0,"issues":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"key":"PROJ-1","fields":{}}`))
+	}))
+	defer jiraServer.Close()
+
+	client, err := jira.NewClient(jira.Config{BaseURL: jiraServer.URL, UserEmail: "bot@example.com", APIToken: "token"}, jiraServer.Client())
+	require.NoError(t, err)
+
+	handlers := NewJiraHandlers(client, slog.New(slog.NewJSONHandler(io.Discard, nil)))
+	handlers.RequestTimeout = 10 * time.Millisecond
+	handlers.RouteTimeouts = map[string]time.Duration{"search": 2 * time.Second}
+
+	router := mux.NewRouter()
+	router.Use(handlers.TimeoutMiddleware)
+	router.HandleFunc("/search_jira_issues", handlers.SearchIssuesHandler).Methods("POST").Name("search")
+	router.HandleFunc("/jira_issue/{issueKey}", handlers.GetIssueDetailsHandler).Methods("GET").Name("get")
+
+	t.Run("Slow search within its route timeout", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/search_jira_issues", strings.NewReader(`{"jql":"project = PROJ"}`)))
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Slow get exceeds the default timeout", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1", nil))
+		assert.Equal(t, http.StatusGatewayTimeout, rr.Code)
+		require.JSONEq(t, `{"error":"Timed out waiting for JIRA."}`, rr.Body.String())
+	})
+}
+
+func TestJiraCallTimeout_SlowJira(t *testing.T) {
+	jiraServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = w.Write([]byte(`{"key":"PROJ-1","fields":{}}`))
+	}))
+	defer jiraServer.Close()
+
+	client, err := jira.NewClient(jira.Config{BaseURL: jiraServer.URL, UserEmail: "bot@example.com", APIToken: "token", RequestTimeout: 20 * time.Millisecond}, jiraServer.Client())
+	require.NoError(t, err)
+
+	// The route allows far longer than the single JIRA call may take
+	handlers := NewJiraHandlers(client, slog.New(slog.NewJSONHandler(io.Discard, nil)))
+	handlers.RequestTimeout = 5 * time.Second
+
+	router := mux.NewRouter()
+	router.Use(handlers.TimeoutMiddleware)
+	router.HandleFunc("/jira_issue/{issueKey}", handlers.GetIssueDetailsHandler).Methods("GET").Name("get")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1", nil))
+	assert.Equal(t, http.StatusGatewayTimeout, rr.Code)
+	require.JSONEq(t, `{"error":"JIRA did not respond within 20ms."}`, rr.Body.String())
+}
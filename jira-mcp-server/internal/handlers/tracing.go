@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing records a server span for every request, continuing the caller's trace
+// if the request carries W3C trace context headers.
+type Tracing struct {
+	tracer trace.Tracer
+}
+
+// NewTracing returns a Tracing creating spans with the given provider, typically
+// otel.GetTracerProvider(), which is a no-op unless tracing has been set up.
+func NewTracing(provider trace.TracerProvider) *Tracing {
+	return &Tracing{tracer: provider.Tracer("jira-mcp-server/internal/handlers")}
+}
+
+// Middleware starts a span named after the request's method and mux route template,
+// e.g. "GET /jira_issue/{issueKey}", and records the response status on it. The
+// span's context is passed on, so JIRA calls made by the handler become its children.
+func (t *Tracing) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.URL.Path
+		attrs := []attribute.KeyValue{attribute.String("http.request.method", r.Method), attribute.String("url.path", r.URL.Path)}
+		if current := mux.CurrentRoute(r); current != nil {
+			if template, err := current.GetPathTemplate(); err == nil {
+				route = template
+				attrs = append(attrs, attribute.String("http.route", template))
+			}
+			if name := current.GetName(); name != "" {
+				attrs = append(attrs, attribute.String("jira_mcp.route_name", name))
+			}
+		}
+
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := t.tracer.Start(ctx, r.Method+" "+route, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attrs...))
+		defer span.End()
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.response.status_code", recorder.status))
+		if recorder.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, strconv.Itoa(recorder.status)+" "+http.StatusText(recorder.status))
+		}
+	})
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// statusCategoryAliases maps the user-facing ?to= filter values to JIRA status category keys.
+var statusCategoryAliases = map[string]string{
+	"to-do":         jira.StatusCategoryToDo,
+	"todo":          jira.StatusCategoryToDo,
+	"new":           jira.StatusCategoryToDo,
+	"in-progress":   jira.StatusCategoryInProgress,
+	"indeterminate": jira.StatusCategoryInProgress,
+	"done":          jira.StatusCategoryDone,
+}
+
+// GetTransitionsHandler handles GET requests to /jira_issue/{issueKey}/transitions.
+// It returns the transitions currently available on the issue. The optional ?to=
+// query parameter (to-do, in-progress or done) restricts the result to transitions
+// whose target status falls in that status category.
+func (h *JiraHandlers) GetTransitionsHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	var category string
+	if to := r.URL.Query().Get("to"); to != "" {
+		var ok bool
+		category, ok = statusCategoryAliases[strings.ToLower(to)]
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "Invalid 'to' filter: expected one of to-do, in-progress, done")
+			return
+		}
+	}
+
+	ctx := r.Context()
+	transitions, err := h.JiraSvc.GetTransitions(ctx, issueKey)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error getting JIRA issue transitions", "issueKey", issueKey, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	filtered := make([]jira.Transition, 0, len(transitions))
+	for _, t := range transitions {
+		if category == "" || t.To.StatusCategory.Key == category {
+			filtered = append(filtered, t)
+		}
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"transitions": filtered})
+}
+
+// TransitionIssueRequest is the body of POST /jira_issue/{issueKey}/transitions.
+// Exactly one of TransitionID and TransitionName must be set.
+type TransitionIssueRequest struct {
+	TransitionID   string `json:"transition_id"`
+	TransitionName string `json:"transition_name"`
+}
+
+// TransitionIssueHandler handles POST requests to /jira_issue/{issueKey}/transitions.
+// It moves the issue through a workflow transition, given either by its ID or by its
+// name (e.g. "Done", matched case-insensitively). Returns 204 No Content on success.
+func (h *JiraHandlers) TransitionIssueHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	var req TransitionIssueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	req.TransitionID = strings.TrimSpace(req.TransitionID)
+	req.TransitionName = strings.TrimSpace(req.TransitionName)
+	if (req.TransitionID == "") == (req.TransitionName == "") {
+		respondWithError(w, http.StatusBadRequest, "Exactly one of transition_id or transition_name is required")
+		return
+	}
+
+	ctx := r.Context()
+	var err error
+	if req.TransitionID != "" {
+		err = h.JiraSvc.TransitionIssue(ctx, issueKey, req.TransitionID)
+	} else {
+		err = h.JiraSvc.TransitionIssueByName(ctx, issueKey, req.TransitionName)
+	}
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error transitioning JIRA issue", "issueKey", issueKey, "transitionId", req.TransitionID, "transitionName", req.TransitionName, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
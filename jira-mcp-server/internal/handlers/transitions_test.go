@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+var testTransitions = []jira.Transition{
+	{ID: "11", Name: "Reopen", To: jira.Status{Name: "To Do", StatusCategory: jira.StatusCategory{Key: jira.StatusCategoryToDo}}},
+	{ID: "21", Name: "Start Progress", To: jira.Status{Name: "In Progress", StatusCategory: jira.StatusCategory{Key: jira.StatusCategoryInProgress}}},
+	{ID: "31", Name: "Done", To: jira.Status{Name: "Done", StatusCategory: jira.StatusCategory{Key: jira.StatusCategoryDone}}},
+	{ID: "41", Name: "Won't Do", To: jira.Status{Name: "Closed", StatusCategory: jira.StatusCategory{Key: jira.StatusCategoryDone}}},
+}
+
+func TestGetTransitionsHandler_FilterDone(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1/transitions?to=done", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetTransitions", mock.Anything, "PROJ-1").Return(testTransitions, nil)
+
+	handlers.GetTransitionsHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{"transitions":[
+		{"id":"31","name":"Done","to":{"id":"","name":"Done","statusCategory":{"id":0,"key":"done","name":""}}},
+		{"id":"41","name":"Won't Do","to":{"id":"","name":"Closed","statusCategory":{"id":0,"key":"done","name":""}}}
+	]}`, rr.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestGetTransitionsHandler_NoFilter(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1/transitions", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetTransitions", mock.Anything, "PROJ-1").Return(testTransitions, nil)
+
+	handlers.GetTransitionsHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"Reopen"`)
+	assert.Contains(t, rr.Body.String(), `"Start Progress"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestGetTransitionsHandler_BadRequest_InvalidFilter(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1/transitions?to=finished", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.GetTransitionsHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "Invalid 'to' filter")
+	mockService.AssertNotCalled(t, "GetTransitions", mock.Anything, mock.Anything)
+}
+
+func TestTransitionIssueHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/jira_issue/PROJ-1/transitions", strings.NewReader(body))
+		return mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	}
+
+	t.Run("By ID", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		rr := httptest.NewRecorder()
+		mockService.On("TransitionIssue", mock.Anything, "PROJ-1", "31").Return(nil)
+
+		NewJiraHandlers(mockService, testLogger).TransitionIssueHandler(rr, newRequest(`{"transition_id":"31"}`))
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("By Name", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		rr := httptest.NewRecorder()
+		mockService.On("TransitionIssueByName", mock.Anything, "PROJ-1", "Done").Return(nil)
+
+		NewJiraHandlers(mockService, testLogger).TransitionIssueHandler(rr, newRequest(`{"transition_name":" Done "}`))
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Unknown Name", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		rr := httptest.NewRecorder()
+		notFound := &jira.TransitionNotFoundError{IssueKey: "PROJ-1", Name: "Closed", Available: testTransitions[:2]}
+		mockService.On("TransitionIssueByName", mock.Anything, "PROJ-1", "Closed").Return(notFound)
+
+		NewJiraHandlers(mockService, testLogger).TransitionIssueHandler(rr, newRequest(`{"transition_name":"Closed"}`))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		require.JSONEq(t, `{"error":"Transition \"Closed\" is not available on PROJ-1. Available transitions: Reopen, Start Progress."}`, rr.Body.String())
+	})
+
+	for name, body := range map[string]string{
+		"Neither Field": `{}`,
+		"Both Fields":   `{"transition_id":"31","transition_name":"Done"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			rr := httptest.NewRecorder()
+
+			NewJiraHandlers(mockService, testLogger).TransitionIssueHandler(rr, newRequest(body))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), "Exactly one of transition_id or transition_name is required")
+			mockService.AssertNotCalled(t, "TransitionIssue", mock.Anything, mock.Anything, mock.Anything)
+			mockService.AssertNotCalled(t, "TransitionIssueByName", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// triageFields is the trimmed field set returned by TriageHandler.
+var triageFields = []string{"summary", "status", "priority", "issuetype", "created", "reporter"}
+
+// triageJQL returns the JQL for a project's unassigned, unresolved issues, oldest first.
+func triageJQL(projectKey string) string {
+	return "project = " + quoteJQLString(projectKey) + " AND assignee is EMPTY AND resolution = Unresolved ORDER BY created ASC"
+}
+
+// defaultMaxResults is the page size used by routes without a DefaultMaxResults entry.
+const defaultMaxResults = 50
+
+// defaultMaxResults returns the page size route uses when a request omits maxResults.
+func (h *JiraHandlers) defaultMaxResults(route string) int {
+	if n := h.DefaultMaxResults[route]; n > 0 {
+		return n
+	}
+	return defaultMaxResults
+}
+
+// parsePaging reads the optional startAt (default 0) and maxResults (default
+// defaultMax) query parameters of GET search endpoints. problem is a user-facing
+// message if either is invalid.
+func parsePaging(query url.Values, defaultMax int) (startAt, maxResults int, problem string) {
+	startAt, maxResults = 0, defaultMax
+	if v := query.Get("startAt"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return 0, 0, "Invalid startAt: expected a non-negative integer"
+		}
+		startAt = n
+	}
+	if v := query.Get("maxResults"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return 0, 0, "Invalid maxResults: expected a positive integer"
+		}
+		maxResults = n
+	}
+	return startAt, maxResults, ""
+}
+
+// TriageHandler handles GET requests to /jira_triage?project=KEY.
+// It returns the project's unassigned, unresolved issues, oldest first, paged by
+// the optional startAt and maxResults query parameters. With ?explain=true it returns
+// the search it would run instead of running it.
+func (h *JiraHandlers) TriageHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	projectKey := strings.ToUpper(strings.TrimSpace(query.Get("project")))
+	if projectKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing required query parameter: project")
+		return
+	}
+
+	startAt, maxResults, problem := parsePaging(query, h.defaultMaxResults("triage"))
+	if problem != "" {
+		respondWithError(w, http.StatusBadRequest, problem)
+		return
+	}
+
+	jql := triageJQL(projectKey)
+	if explainRequested(r) {
+		respondWithJSON(w, http.StatusOK, explainResponse{JQL: jql, StartAt: startAt, MaxResults: maxResults, Fields: triageFields})
+		return
+	}
+
+	ctx := r.Context()
+	resp, err := h.JiraSvc.SearchIssues(ctx, jql, startAt, maxResults, triageFields)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error searching triage issues", "project", projectKey, "jql", jql, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	h.prepareIssuesResponse(r, resp.Issues)
+	respondWithJSON(w, http.StatusOK, resp)
+}
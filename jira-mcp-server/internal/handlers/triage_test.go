@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestTriageHandler_Success(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_triage?project=proj&startAt=50&maxResults=25", nil)
+	rr := httptest.NewRecorder()
+
+	var gotJQL string
+	mockService.On("SearchIssues", mock.Anything, mock.AnythingOfType("string"), 50, 25, triageFields).
+		Run(func(args mock.Arguments) { gotJQL = args.String(1) }).
+		Return(&jira.SearchResponse{StartAt: 50, MaxResults: 25, Total: 51, Issues: []jira.Issue{{Key: "PROJ-9"}}}, nil)
+
+	handlers.TriageHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, gotJQL, `project = "PROJ"`)
+	assert.Contains(t, gotJQL, "assignee is EMPTY")
+	assert.Contains(t, gotJQL, "resolution = Unresolved")
+	assert.Contains(t, gotJQL, "ORDER BY created ASC")
+	assert.Contains(t, rr.Body.String(), `"key":"PROJ-9"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestTriageHandler_BadRequest(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	cases := map[string]string{
+		"/jira_triage":                           "Missing required query parameter: project",
+		"/jira_triage?project=PROJ&startAt=-1":   "Invalid startAt: expected a non-negative integer",
+		"/jira_triage?project=PROJ&maxResults=0": "Invalid maxResults: expected a positive integer",
+	}
+	for target, message := range cases {
+		rr := httptest.NewRecorder()
+		handlers.TriageHandler(rr, httptest.NewRequest(http.MethodGet, target, nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code, target)
+		require.JSONEq(t, `{"error":"`+message+`"}`, rr.Body.String())
+	}
+	mockService.AssertNotCalled(t, "SearchIssues", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDefaultMaxResults_PerRoute(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+	handlers.DefaultMaxResults = map[string]int{"search": 11, "epic": 12, "changed": 13, "triage": 14, "filter_issues": 15}
+
+	page := &jira.SearchResponse{Total: 1, Issues: []jira.Issue{{Key: "PROJ-1"}}}
+	mockService.On("SearchIssues", mock.Anything, "project = PROJ", 0, 11, []string(nil)).Return(page, nil).Once()
+	mockService.On("ResolveEpicLinkField", mock.Anything).Return("customfield_10014", nil)
+	mockService.On("SearchIssues", mock.Anything, "'customfield_10014' = 'EPIC-1'", 0, 12, []string(nil)).Return(page, nil).Once()
+	mockService.On("SearchIssuesWithExpand", mock.Anything, mock.AnythingOfType("string"), 0, 13, []string(nil), []string{"changelog"}).Return(page, nil).Once()
+	mockService.On("SearchIssues", mock.Anything, triageJQL("PROJ"), 0, 14, triageFields).Return(page, nil).Once()
+	mockService.On("GetFilter", mock.Anything, "10001").Return(&jira.Filter{ID: "10001", JQL: "priority = Blocker"}, nil)
+	mockService.On("SearchIssues", mock.Anything, "priority = Blocker", 0, 15, []string(nil)).Return(page, nil).Once()
+
+	requests := []struct {
+		handler http.HandlerFunc
+		req     *http.Request
+	}{
+		{handlers.SearchIssuesHandler, httptest.NewRequest(http.MethodPost, "/search_jira_issues", strings.NewReader(`{"jql": "project = PROJ"}`))},
+		{handlers.GetIssuesInEpicHandler, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/jira_epic/EPIC-1/issues", nil), map[string]string{"epicKey": "EPIC-1"})},
+		{handlers.ChangedIssuesHandler, httptest.NewRequest(http.MethodPost, "/jira_changed", strings.NewReader(`{"jql": "project = PROJ", "since": "2024-01-01T00:00:00Z"}`))},
+		{handlers.TriageHandler, httptest.NewRequest(http.MethodGet, "/jira_triage?project=PROJ", nil)},
+		{handlers.FilterIssuesHandler, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/jira_filter/10001/issues", nil), map[string]string{"filterID": "10001"})},
+	}
+	for _, r := range requests {
+		rr := httptest.NewRecorder()
+		r.handler(rr, r.req)
+		assert.Equal(t, http.StatusOK, rr.Code, r.req.URL.Path)
+	}
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// UpdateJiraIssueHandler handles PUT requests to /jira_issue/{issueKey}.
+// It accepts the same snake_case body as create with every field optional, e.g.
+// {"summary": "...", "description": "..."}, and only changes the fields present.
+func (h *JiraHandlers) UpdateJiraIssueHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPut {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	var req jira.UpdateIssueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	if req.IsEmpty() {
+		respondWithError(w, http.StatusBadRequest, "No fields to update: provide at least one of summary, description, environment, issue_type")
+		return
+	}
+	if req.Summary != nil && *req.Summary == "" {
+		respondWithError(w, http.StatusBadRequest, "summary cannot be empty")
+		return
+	}
+	if req.IssueType != nil && *req.IssueType == "" {
+		respondWithError(w, http.StatusBadRequest, "issue_type cannot be empty")
+		return
+	}
+	if !jira.IsTextFormat(req.DescriptionFormat) {
+		respondWithError(w, http.StatusBadRequest, "Invalid description_format: expected plain or markdown")
+		return
+	}
+	if req.Description != nil && h.descriptionTooLong(*req.Description) {
+		respondWithError(w, http.StatusBadRequest, fmt.Sprintf("Description exceeds maximum length of %d characters", h.MaxDescriptionLength))
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.JiraSvc.UpdateIssue(ctx, issueKey, req); err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error updating JIRA issue", "issueKey", issueKey, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "JIRA issue updated successfully"})
+}
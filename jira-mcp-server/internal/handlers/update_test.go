@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestUpdateJiraIssueHandler_Success(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	summary := "Renamed"
+	mockService.On("UpdateIssue", mock.Anything, "PROJ-1", jira.UpdateIssueRequest{Summary: &summary}).Return(nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/jira_issue/PROJ-1", strings.NewReader(`{"summary":"Renamed"}`))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.UpdateJiraIssueHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{"message":"JIRA issue updated successfully"}`, rr.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestUpdateJiraIssueHandler_JiraErrors(t *testing.T) {
+	testCases := []struct {
+		name         string
+		jiraStatus   int
+		expectedCode int
+		expectedBody string
+	}{
+		{"Not Found", http.StatusNotFound, http.StatusNotFound, `{"error":"JIRA resource not found."}`},
+		{"Bad Request", http.StatusBadRequest, http.StatusBadRequest, `{"error":"Invalid request data sent to JIRA."}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(mockJiraService)
+			testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+			handlers := NewJiraHandlers(mockService, testLogger)
+
+			mockService.On("UpdateIssue", mock.Anything, "PROJ-1", mock.Anything).Return(&jira.JiraAPIError{StatusCode: tc.jiraStatus})
+
+			req := httptest.NewRequest(http.MethodPut, "/jira_issue/PROJ-1", strings.NewReader(`{"issue_type":"Bug"}`))
+			req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+			rr := httptest.NewRecorder()
+
+			handlers.UpdateJiraIssueHandler(rr, req)
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+			require.JSONEq(t, tc.expectedBody, rr.Body.String())
+		})
+	}
+}
+
+func TestUpdateJiraIssueHandler_NoFields(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodPut, "/jira_issue/PROJ-1", strings.NewReader(`{}`))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.UpdateJiraIssueHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "No fields to update")
+	mockService.AssertNotCalled(t, "UpdateIssue", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateJiraIssueHandler_InvalidDescriptionFormat(t *testing.T) {
+	mockService := new(mockJiraService) // Service shouldn't be called
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodPut, "/jira_issue/PROJ-1", strings.NewReader(`{"description":"<b>hi</b>","description_format":"html"}`))
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	handlers.UpdateJiraIssueHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	require.JSONEq(t, `{"error":"Invalid description_format: expected plain or markdown"}`, rr.Body.String())
+	mockService.AssertNotCalled(t, "UpdateIssue", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// maxUserLookupsPerRequest bounds the distinct accounts resolved by resolveUserNames
+// for a single request; references beyond it are returned unresolved.
+const maxUserLookupsPerRequest = 20
+
+// resolveUserNames adds a displayName to every user reference in the issues'
+// fields that only carries an accountId, as custom user-picker fields may.
+// Lookups that fail are logged and the reference is left as is.
+func (h *JiraHandlers) resolveUserNames(ctx context.Context, issues []jira.Issue) {
+	names := make(map[string]string)
+	lookups := 0
+	var visit func(value interface{})
+	visit = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			accountID, _ := v["accountId"].(string)
+			if _, named := v["displayName"]; accountID != "" && !named {
+				name, seen := names[accountID]
+				if !seen && lookups < maxUserLookupsPerRequest {
+					lookups++
+					if user, err := h.JiraSvc.GetUser(ctx, accountID); err != nil {
+						h.Logger.WarnContext(ctx, "Could not resolve JIRA user name", "accountId", accountID, "error", err)
+					} else {
+						name = user.DisplayName
+					}
+					names[accountID] = name
+				}
+				if name != "" {
+					v["displayName"] = name
+				}
+			}
+			for _, nested := range v {
+				visit(nested)
+			}
+		case []interface{}:
+			for _, nested := range v {
+				visit(nested)
+			}
+		}
+	}
+
+	for _, issue := range issues {
+		for _, value := range issue.Fields {
+			visit(value)
+		}
+	}
+}
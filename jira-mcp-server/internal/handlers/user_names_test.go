@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestSearchJiraIssuesHandler_ResolveNames(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	mockService.On("SearchIssues", mock.Anything, "project = PROJ", 0, 50, []string{"assignee", "customfield_10060"}).Return(&jira.SearchResponse{
+		Total: 2,
+		Issues: []jira.Issue{
+			{Key: "PROJ-1", Fields: map[string]interface{}{
+				"assignee":          map[string]interface{}{"accountId": "abc-1", "displayName": "Jane Doe"},
+				"customfield_10060": map[string]interface{}{"accountId": "xyz-9"},
+			}},
+			{Key: "PROJ-2", Fields: map[string]interface{}{
+				"customfield_10060": []interface{}{map[string]interface{}{"accountId": "xyz-9"}},
+			}},
+		},
+	}, nil)
+	mockService.On("GetUser", mock.Anything, "xyz-9").Return(&jira.User{AccountID: "xyz-9", DisplayName: "Reviewer Rae"}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/search_jira_issues?names=true", strings.NewReader(`{"jql":"project = PROJ","fields":["assignee","customfield_10060"]}`))
+	rr := httptest.NewRecorder()
+
+	handlers.SearchIssuesHandler(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"customfield_10060":{"accountId":"xyz-9","displayName":"Reviewer Rae"}`)
+	assert.Contains(t, rr.Body.String(), `"customfield_10060":[{"accountId":"xyz-9","displayName":"Reviewer Rae"}]`)
+	mockService.AssertExpectations(t) // GetUser once: repeated accounts are looked up only once
+}
+
+func TestResolveUserNames_BoundedLookups(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	var refs []interface{}
+	for i := 0; i < maxUserLookupsPerRequest+5; i++ {
+		refs = append(refs, map[string]interface{}{"accountId": fmt.Sprintf("acct-%d", i)})
+	}
+	mockService.On("GetUser", mock.Anything, mock.Anything).Return(nil, errors.New("lookup failed"))
+
+	handlers.resolveUserNames(context.Background(), []jira.Issue{{Key: "PROJ-1", Fields: map[string]interface{}{"customfield_10060": refs}}})
+
+	mockService.AssertNumberOfCalls(t, "GetUser", maxUserLookupsPerRequest)
+	assert.NotContains(t, refs[0], "displayName", "Failed lookups leave the reference unchanged")
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// AddWatcherRequest is the body of POST /jira_issue/{issueKey}/watchers.
+// Exactly one of AccountID and Email must be set.
+type AddWatcherRequest struct {
+	AccountID string `json:"account_id"`
+	Email     string `json:"email"`
+}
+
+// GetWatchersHandler handles GET requests to /jira_issue/{issueKey}/watchers.
+// It returns the users watching the issue.
+func (h *JiraHandlers) GetWatchersHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodGet {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	ctx := r.Context()
+	watchers, err := h.JiraSvc.GetWatchers(ctx, issueKey)
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error getting JIRA issue watchers", "issueKey", issueKey, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, watchers)
+}
+
+// AddWatcherHandler handles POST requests to /jira_issue/{issueKey}/watchers.
+// It adds the user given by {"account_id": "..."} or {"email": "..."} as a watcher,
+// so that they are notified of changes. Returns 204 No Content on success.
+func (h *JiraHandlers) AddWatcherHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodPost {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	var req AddWatcherRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Logger.ErrorContext(r.Context(), "Failed to decode request body", "error", err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	h.changeWatcher(w, r, issueKey, req.AccountID, req.Email, h.JiraSvc.AddWatcher)
+}
+
+// RemoveWatcherHandler handles DELETE requests to /jira_issue/{issueKey}/watchers.
+// It removes the watcher given by the account_id or email query parameter.
+// Returns 204 No Content on success.
+func (h *JiraHandlers) RemoveWatcherHandler(w http.ResponseWriter, r *http.Request) {
+	h.Logger.InfoContext(r.Context(), "Request received", "method", r.Method, "path", r.URL.Path)
+
+	if r.Method != http.MethodDelete {
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	issueKey := h.issueKeyVar(r, "issueKey")
+	if issueKey == "" {
+		respondWithError(w, http.StatusBadRequest, "Missing issue key in URL path")
+		return
+	}
+	if !ValidIssueKey(issueKey) {
+		respondWithError(w, http.StatusBadRequest, invalidIssueKeyMessage)
+		return
+	}
+
+	query := r.URL.Query()
+	h.changeWatcher(w, r, issueKey, query.Get("account_id"), query.Get("email"), h.JiraSvc.RemoveWatcher)
+}
+
+// changeWatcher applies change to the user given by accountID or email, resolving
+// an email to its accountId first, and writes the response.
+func (h *JiraHandlers) changeWatcher(w http.ResponseWriter, r *http.Request, issueKey, accountID, email string,
+	change func(ctx context.Context, issueKey, accountID string) error) {
+	accountID, email = strings.TrimSpace(accountID), strings.TrimSpace(email)
+	if (accountID == "") == (email == "") {
+		respondWithError(w, http.StatusBadRequest, "Exactly one of account_id or email is required")
+		return
+	}
+
+	ctx := r.Context()
+	var err error
+	if email != "" {
+		// Unknown and ambiguous emails are reported by mapJiraError as 400 and 409
+		accountID, err = h.JiraSvc.ResolveAccountID(ctx, email)
+	}
+	if err == nil {
+		err = change(ctx, issueKey, accountID)
+	}
+	if err != nil {
+		statusCode, userMessage := mapJiraError(err)
+		h.Logger.ErrorContext(ctx, "Error changing JIRA issue watchers", "method", r.Method, "issueKey", issueKey, "accountId", accountID, "email", email, "error", err)
+		respondWithError(w, statusCode, userMessage)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
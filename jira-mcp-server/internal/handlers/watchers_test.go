@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestGetWatchersHandler(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodGet, "/jira_issue/PROJ-1/watchers", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	mockService.On("GetWatchers", mock.Anything, "PROJ-1").Return(&jira.Watchers{
+		WatchCount: 1,
+		Watchers:   []jira.User{{AccountID: "abc-1", DisplayName: "Jane Doe"}},
+	}, nil)
+
+	handlers.GetWatchersHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"watchCount":1`)
+	assert.Contains(t, rr.Body.String(), `"accountId":"abc-1"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestAddWatcherHandler(t *testing.T) {
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/jira_issue/PROJ-1/watchers", strings.NewReader(body))
+		return mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	}
+
+	t.Run("By Account ID", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		rr := httptest.NewRecorder()
+		mockService.On("AddWatcher", mock.Anything, "PROJ-1", "abc-1").Return(nil)
+
+		NewJiraHandlers(mockService, testLogger).AddWatcherHandler(rr, newRequest(`{"account_id":"abc-1"}`))
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("By Email", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		rr := httptest.NewRecorder()
+		mockService.On("ResolveAccountID", mock.Anything, "dev@example.com").Return("abc-1", nil)
+		mockService.On("AddWatcher", mock.Anything, "PROJ-1", "abc-1").Return(nil)
+
+		NewJiraHandlers(mockService, testLogger).AddWatcherHandler(rr, newRequest(`{"email":"dev@example.com"}`))
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Unknown Email", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		rr := httptest.NewRecorder()
+		mockService.On("ResolveAccountID", mock.Anything, "nobody@example.com").Return("", &jira.UserNotFoundError{Email: "nobody@example.com"})
+
+		NewJiraHandlers(mockService, testLogger).AddWatcherHandler(rr, newRequest(`{"email":"nobody@example.com"}`))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		require.JSONEq(t, `{"error":"No JIRA user found for email nobody@example.com."}`, rr.Body.String())
+		mockService.AssertNotCalled(t, "AddWatcher", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Missing User", func(t *testing.T) {
+		mockService := new(mockJiraService)
+		rr := httptest.NewRecorder()
+
+		NewJiraHandlers(mockService, testLogger).AddWatcherHandler(rr, newRequest(`{}`))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Exactly one of account_id or email is required")
+	})
+}
+
+func TestRemoveWatcherHandler(t *testing.T) {
+	mockService := new(mockJiraService)
+	testLogger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	handlers := NewJiraHandlers(mockService, testLogger)
+
+	req := httptest.NewRequest(http.MethodDelete, "/jira_issue/PROJ-1/watchers?account_id=abc-1", nil)
+	req = mux.SetURLVars(req, map[string]string{"issueKey": "PROJ-1"})
+	rr := httptest.NewRecorder()
+
+	mockService.On("RemoveWatcher", mock.Anything, "PROJ-1", "abc-1").Return(nil)
+
+	handlers.RemoveWatcherHandler(rr, req)
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	mockService.AssertExpectations(t)
+}
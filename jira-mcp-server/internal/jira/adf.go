@@ -0,0 +1,181 @@
+package jira
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxADFParagraphs caps the number of paragraph nodes produced when converting
+// plain text to Atlassian Document Format (ADF). Any text beyond the cap is
+// folded into the final paragraph so that pathological inputs (e.g. thousands
+// of blank-line separated fragments) cannot blow up the payload sent to JIRA.
+const MaxADFParagraphs = 100
+
+// textToADF converts a plain-text string into an ADF "doc" node.
+// Blank-line separated blocks become individual paragraph nodes, up to MaxADFParagraphs,
+// and single newlines within a block become hardBreak nodes.
+func textToADF(text string) map[string]interface{} {
+	blocks := splitParagraphs(text)
+	if len(blocks) > MaxADFParagraphs {
+		// Fold the overflow into the last allowed paragraph rather than dropping it
+		tail := strings.Join(blocks[MaxADFParagraphs-1:], "\n\n")
+		blocks = append(blocks[:MaxADFParagraphs-1], tail)
+	}
+
+	content := make([]map[string]interface{}, 0, len(blocks))
+	for _, block := range blocks {
+		content = append(content, map[string]interface{}{
+			"type":    "paragraph",
+			"content": lineBreakContent(block),
+		})
+	}
+
+	return map[string]interface{}{
+		"type":    "doc",
+		"version": 1,
+		"content": content,
+	}
+}
+
+// lineBreakContent converts a block of text to inline ADF content, with a
+// hardBreak node in place of each newline. Empty lines produce no text node,
+// as ADF does not allow empty text.
+func lineBreakContent(block string) []map[string]interface{} {
+	content := []map[string]interface{}{}
+	for i, line := range strings.Split(block, "\n") {
+		if i > 0 {
+			content = append(content, map[string]interface{}{"type": "hardBreak"})
+		}
+		if line != "" {
+			content = append(content, map[string]interface{}{"type": "text", "text": line})
+		}
+	}
+	return content
+}
+
+// splitParagraphs splits text on blank lines, discarding empty blocks.
+// Text without any blank lines is returned as a single block.
+func splitParagraphs(text string) []string {
+	normalized := strings.ReplaceAll(text, "\r\n", "\n")
+	var blocks []string
+	for _, block := range strings.Split(normalized, "\n\n") {
+		if strings.TrimSpace(block) == "" {
+			continue
+		}
+		blocks = append(blocks, strings.Trim(block, "\n"))
+	}
+	if len(blocks) == 0 {
+		// Preserve the original text (e.g. whitespace-only) as a single paragraph
+		blocks = []string{text}
+	}
+	return blocks
+}
+
+// adfToPlainText flattens an ADF node, as decoded from JSON, into readable text.
+// Paragraphs and headings are separated by blank lines, hard breaks become newlines
+// and list items are prefixed with "- " or their number. Other container nodes
+// contribute the text they contain; unknown leaf nodes are skipped.
+func adfToPlainText(node interface{}) string {
+	return strings.TrimSpace(adfBlockText(node))
+}
+
+// adfBlockText renders a block-level node.
+func adfBlockText(node interface{}) string {
+	n, ok := node.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	switch n["type"] {
+	case "text":
+		text, _ := n["text"].(string)
+		return text
+	case "hardBreak":
+		return "\n"
+	case "paragraph", "heading":
+		return adfInlineText(n["content"])
+	case "bulletList", "orderedList":
+		return adfListText(n)
+	}
+
+	var blocks []string
+	for _, child := range adfChildren(n["content"]) {
+		if text := adfBlockText(child); strings.TrimSpace(text) != "" {
+			blocks = append(blocks, text)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// adfInlineText concatenates the text of inline nodes.
+func adfInlineText(content interface{}) string {
+	var sb strings.Builder
+	for _, child := range adfChildren(content) {
+		n, ok := child.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch n["type"] {
+		case "text":
+			text, _ := n["text"].(string)
+			sb.WriteString(text)
+		case "hardBreak":
+			sb.WriteString("\n")
+		default:
+			sb.WriteString(adfInlineText(n["content"]))
+		}
+	}
+	return sb.String()
+}
+
+// adfListText renders a bullet or ordered list, one item per line. Continuation
+// lines, including nested lists, are indented under the item's marker.
+func adfListText(list map[string]interface{}) string {
+	number := 1
+	if attrs, ok := list["attrs"].(map[string]interface{}); ok {
+		if order, err := strconv.Atoi(fmt.Sprint(attrs["order"])); err == nil {
+			number = order
+		}
+	}
+
+	var lines []string
+	for _, item := range adfChildren(list["content"]) {
+		marker := "- "
+		if list["type"] == "orderedList" {
+			marker = fmt.Sprintf("%d. ", number)
+			number++
+		}
+
+		var blocks []string
+		if n, ok := item.(map[string]interface{}); ok {
+			for _, child := range adfChildren(n["content"]) {
+				if text := adfBlockText(child); strings.TrimSpace(text) != "" {
+					blocks = append(blocks, text)
+				}
+			}
+		}
+		indent := "\n" + strings.Repeat(" ", len(marker))
+		lines = append(lines, marker+strings.ReplaceAll(strings.Join(blocks, "\n"), "\n", indent))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// adfChildren returns a node's content array, or nil if it has none.
+func adfChildren(content interface{}) []interface{} {
+	children, _ := content.([]interface{})
+	return children
+}
+
+// isADFDoc reports whether value is a decoded ADF document.
+func isADFDoc(value interface{}) bool {
+	n, ok := value.(map[string]interface{})
+	return ok && n["type"] == "doc"
+}
+
+// RenderDescription replaces an ADF description in the issue's fields with its
+// plain-text rendering (see adfToPlainText). Other description values are left as is.
+func (i *Issue) RenderDescription() {
+	if description, ok := i.Fields["description"]; ok && isADFDoc(description) {
+		i.Fields["description"] = adfToPlainText(description)
+	}
+}
@@ -0,0 +1,68 @@
+package jira_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// issueWithDescription decodes an issue whose description is the given ADF
+// document, the same way issue responses are decoded.
+func issueWithDescription(t *testing.T, doc string) *jira.Issue {
+	t.Helper()
+	var issue jira.Issue
+	dec := json.NewDecoder(strings.NewReader(`{"key":"PROJ-1","fields":{"summary":"Untouched","description":` + doc + `}}`))
+	dec.UseNumber()
+	require.NoError(t, dec.Decode(&issue))
+	return &issue
+}
+
+func TestIssue_RenderDescription(t *testing.T) {
+	t.Run("Paragraphs Lists And Unknown Nodes", func(t *testing.T) {
+		issue := issueWithDescription(t, `{"type":"doc","version":1,"content":[
+			{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Steps"}]},
+			{"type":"paragraph","content":[
+				{"type":"text","text":"First line"},
+				{"type":"hardBreak"},
+				{"type":"text","text":"second ","marks":[{"type":"strong"}]},
+				{"type":"text","text":"line"}
+			]},
+			{"type":"orderedList","attrs":{"order":3},"content":[
+				{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"Open the app"}]}]},
+				{"type":"listItem","content":[
+					{"type":"paragraph","content":[{"type":"text","text":"Click save"}]},
+					{"type":"bulletList","content":[
+						{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"twice"}]}]}
+					]}
+				]}
+			]},
+			{"type":"mediaSingle","content":[{"type":"media","attrs":{"id":"abc"}}]},
+			{"type":"panel","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"Ping "},
+				{"type":"mention","attrs":{"id":"123","text":"@Ann"}}
+			]}]}
+		]}`)
+
+		issue.RenderDescription()
+
+		expected := "Steps\n\n" +
+			"First line\nsecond line\n\n" +
+			"3. Open the app\n4. Click save\n   - twice\n\n" +
+			"Ping"
+		assert.Equal(t, expected, issue.Fields["description"])
+		assert.Equal(t, "Untouched", issue.Fields["summary"])
+	})
+
+	t.Run("Non-ADF Description Left Alone", func(t *testing.T) {
+		issue := issueWithDescription(t, `null`)
+
+		issue.RenderDescription()
+
+		assert.Nil(t, issue.Fields["description"])
+	})
+}
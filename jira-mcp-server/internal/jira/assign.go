@@ -0,0 +1,36 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// DefaultAssigneeAccountID is the special accountId that makes JIRA assign an
+// issue to its project's default assignee ("automatic" assignment in the UI).
+const DefaultAssigneeAccountID = "-1"
+
+// AssignIssue assigns an issue to the user with the given accountId using
+// PUT /rest/api/3/issue/{issueKey}/assignee. Pass DefaultAssigneeAccountID to
+// assign the project's default assignee; use UnassignIssue to clear the assignee.
+func (c *Client) AssignIssue(ctx context.Context, issueKey, accountID string) error {
+	if issueKey == "" || accountID == "" {
+		return fmt.Errorf("issue key and account ID are required")
+	}
+	defer c.issueCache.invalidate(issueKey)
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s/assignee", url.PathEscape(issueKey))
+	return c.doJSON(ctx, "PUT", path, map[string]string{"accountId": accountID}, nil)
+}
+
+// UnassignIssue removes an issue's assignee by sending {"accountId": null} to
+// PUT /rest/api/3/issue/{issueKey}/assignee.
+func (c *Client) UnassignIssue(ctx context.Context, issueKey string) error {
+	if issueKey == "" {
+		return fmt.Errorf("issue key cannot be empty")
+	}
+	defer c.issueCache.invalidate(issueKey)
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s/assignee", url.PathEscape(issueKey))
+	return c.doJSON(ctx, "PUT", path, map[string]interface{}{"accountId": nil}, nil)
+}
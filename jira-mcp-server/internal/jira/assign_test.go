@@ -0,0 +1,57 @@
+package jira_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_AssignIssue(t *testing.T) {
+	testCases := []struct {
+		name         string
+		assign       func(*jira.Client) error
+		expectedBody string
+	}{
+		{
+			name:         "Specific User",
+			assign:       func(c *jira.Client) error { return c.AssignIssue(context.Background(), "PROJ-1", "abc-1") },
+			expectedBody: `{"accountId":"abc-1"}`,
+		},
+		{
+			name: "Default Assignee",
+			assign: func(c *jira.Client) error {
+				return c.AssignIssue(context.Background(), "PROJ-1", jira.DefaultAssigneeAccountID)
+			},
+			expectedBody: `{"accountId":"-1"}`,
+		},
+		{
+			name:         "Unassigned",
+			assign:       func(c *jira.Client) error { return c.UnassignIssue(context.Background(), "PROJ-1") },
+			expectedBody: `{"accountId":null}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "PUT", r.Method)
+				assert.Equal(t, "/rest/api/3/issue/PROJ-1/assignee", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, tc.expectedBody, string(body))
+				w.WriteHeader(http.StatusNoContent)
+			}
+
+			server, client := setupTestServer(t, handler)
+			defer server.Close()
+
+			require.NoError(t, tc.assign(client))
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// DefaultAttachmentMaxBytes is the largest file AddAttachmentFromURL downloads
+// when Config.AttachmentMaxBytes is unset.
+const DefaultAttachmentMaxBytes = 10 << 20
+
+// DefaultAttachmentFetchTimeout bounds the download in AddAttachmentFromURL
+// when Config.AttachmentFetchTimeout is unset.
+const DefaultAttachmentFetchTimeout = 30 * time.Second
+
+// AttachmentSourceError is returned by AddAttachmentFromURL when the source URL
+// is rejected or cannot be downloaded. Nothing is uploaded to JIRA in that case.
+type AttachmentSourceError struct {
+	URL    string
+	Reason string
+}
+
+func (e *AttachmentSourceError) Error() string {
+	return fmt.Sprintf("cannot attach from %s: %s", e.URL, e.Reason)
+}
+
+// AddAttachmentFromURL downloads rawURL and attaches it to an issue as filename.
+// The URL must use http or https and its host must be in Config.AttachmentURLHosts,
+// including for any redirects, so that callers cannot make the server fetch
+// internal addresses. The download is limited to Config.AttachmentMaxBytes and
+// Config.AttachmentFetchTimeout. If filename is empty, the last path segment of
+// the URL is used.
+func (c *Client) AddAttachmentFromURL(ctx context.Context, issueKey, rawURL, filename string) error {
+	if issueKey == "" {
+		return fmt.Errorf("issue key cannot be empty")
+	}
+	defer c.issueCache.invalidate(issueKey)
+
+	u, err := c.checkAttachmentURL(rawURL)
+	if err != nil {
+		return err
+	}
+	if filename == "" {
+		filename = path.Base(u.Path)
+		if filename == "." || filename == "/" {
+			filename = "attachment"
+		}
+	}
+
+	content, err := c.fetchAttachment(ctx, u)
+	if err != nil {
+		return err
+	}
+	return c.uploadAttachment(ctx, issueKey, filename, content)
+}
+
+// checkAttachmentURL parses rawURL and verifies it against the scheme and host allowlist.
+func (c *Client) checkAttachmentURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, &AttachmentSourceError{URL: rawURL, Reason: "not a valid URL"}
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, &AttachmentSourceError{URL: rawURL, Reason: "scheme must be http or https"}
+	}
+	if u.Host == "" {
+		return nil, &AttachmentSourceError{URL: rawURL, Reason: "missing host"}
+	}
+	if !c.attachmentHostAllowed(u) {
+		return nil, &AttachmentSourceError{URL: rawURL, Reason: fmt.Sprintf("host %s is not allowed", u.Hostname())}
+	}
+	return u, nil
+}
+
+// attachmentHostAllowed reports whether u's host (with or without port) is allowlisted.
+func (c *Client) attachmentHostAllowed(u *url.URL) bool {
+	for _, host := range c.attachmentURLHosts {
+		if strings.EqualFold(host, u.Hostname()) || strings.EqualFold(host, u.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchAttachment downloads u, failing if the content exceeds the size cap.
+func (c *Client) fetchAttachment(ctx context.Context, u *url.URL) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.attachmentFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %v", err)
+	}
+	resp, err := c.attachmentClient.Do(req)
+	if err != nil {
+		var sourceErr *AttachmentSourceError
+		if errors.As(err, &sourceErr) {
+			return nil, sourceErr
+		}
+		return nil, &AttachmentSourceError{URL: u.String(), Reason: fmt.Sprintf("download failed: %v", err)}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &AttachmentSourceError{URL: u.String(), Reason: fmt.Sprintf("download returned HTTP %d", resp.StatusCode)}
+	}
+	tooLarge := &AttachmentSourceError{URL: u.String(), Reason: fmt.Sprintf("file exceeds the %d byte limit", c.attachmentMaxBytes)}
+	if resp.ContentLength > c.attachmentMaxBytes {
+		return nil, tooLarge
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, c.attachmentMaxBytes+1))
+	if err != nil {
+		return nil, &AttachmentSourceError{URL: u.String(), Reason: fmt.Sprintf("download failed: %v", err)}
+	}
+	if int64(len(content)) > c.attachmentMaxBytes {
+		return nil, tooLarge
+	}
+	return content, nil
+}
+
+// checkAttachmentRedirect is the attachment client's CheckRedirect; it applies the
+// host allowlist to every redirect target.
+func (c *Client) checkAttachmentRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return &AttachmentSourceError{URL: via[0].URL.String(), Reason: "too many redirects"}
+	}
+	_, err := c.checkAttachmentURL(req.URL.String())
+	return err
+}
+
+// uploadAttachment attaches content to an issue using the multipart
+// POST /rest/api/3/issue/{issueKey}/attachments endpoint.
+func (c *Client) uploadAttachment(ctx context.Context, issueKey, filename string, content []byte) error {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("file", filename)
+	if err != nil {
+		return fmt.Errorf("failed to build multipart body: %v", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return fmt.Errorf("failed to build multipart body: %v", err)
+	}
+	if err := mw.Close(); err != nil {
+		return fmt.Errorf("failed to build multipart body: %v", err)
+	}
+
+	requestURL := c.requestURL(fmt.Sprintf("/rest/api/3/issue/%s/attachments", url.PathEscape(issueKey)))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", requestURL, &body)
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %v", err)
+	}
+	httpReq.Header.Set("Content-Type", mw.FormDataContentType())
+	// JIRA rejects multipart uploads without this header as a CSRF precaution.
+	httpReq.Header.Set("X-Atlassian-Token", "no-check")
+
+	return c.do(ctx, httpReq, nil)
+}
@@ -0,0 +1,108 @@
+package jira_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_AddAttachmentFromURL(t *testing.T) {
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"), "JIRA credentials must not be sent to the source")
+		switch r.URL.Path {
+		case "/logs/build.log":
+			_, _ = w.Write([]byte("build output"))
+		case "/big.bin":
+			_, _ = w.Write(make([]byte, 64))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer source.Close()
+	sourceURL, err := url.Parse(source.URL)
+	require.NoError(t, err)
+
+	newClient := func(t *testing.T, jiraServer *httptest.Server, hosts ...string) *jira.Client {
+		t.Helper()
+		client, err := jira.NewClient(jira.Config{
+			BaseURL:            jiraServer.URL,
+			UserEmail:          "test@example.com",
+			APIToken:           "test-token",
+			AttachmentURLHosts: hosts,
+			AttachmentMaxBytes: 32,
+		}, jiraServer.Client())
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		var uploaded bool
+		jiraServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "/rest/api/3/issue/PROJ-1/attachments", r.URL.Path)
+			assert.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+			file, header, err := r.FormFile("file")
+			require.NoError(t, err)
+			content, _ := io.ReadAll(file)
+			assert.Equal(t, "build.log", header.Filename)
+			assert.Equal(t, "build output", string(content))
+			uploaded = true
+			_, _ = w.Write([]byte(`[{"id":"10001","filename":"build.log"}]`))
+		}))
+		defer jiraServer.Close()
+
+		client := newClient(t, jiraServer, sourceURL.Hostname())
+		err := client.AddAttachmentFromURL(context.Background(), "PROJ-1", source.URL+"/logs/build.log", "")
+
+		require.NoError(t, err)
+		assert.True(t, uploaded)
+	})
+
+	t.Run("Error Host Not Allowed", func(t *testing.T) {
+		jiraServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("JIRA should not be called for a blocked URL")
+		}))
+		defer jiraServer.Close()
+
+		client := newClient(t, jiraServer, "files.example.com")
+		err := client.AddAttachmentFromURL(context.Background(), "PROJ-1", source.URL+"/logs/build.log", "build.log")
+
+		var sourceErr *jira.AttachmentSourceError
+		require.True(t, errors.As(err, &sourceErr))
+		assert.Contains(t, sourceErr.Reason, "is not allowed")
+	})
+
+	t.Run("Error Too Large", func(t *testing.T) {
+		jiraServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("JIRA should not be called for an oversized file")
+		}))
+		defer jiraServer.Close()
+
+		client := newClient(t, jiraServer, sourceURL.Host)
+		err := client.AddAttachmentFromURL(context.Background(), "PROJ-1", source.URL+"/big.bin", "")
+
+		var sourceErr *jira.AttachmentSourceError
+		require.True(t, errors.As(err, &sourceErr))
+		assert.Contains(t, sourceErr.Reason, "exceeds the 32 byte limit")
+	})
+
+	t.Run("Error Unsupported Scheme", func(t *testing.T) {
+		client, err := jira.NewClient(dummyConfig, nil)
+		require.NoError(t, err)
+
+		err = client.AddAttachmentFromURL(context.Background(), "PROJ-1", "file:///etc/passwd", "")
+
+		var sourceErr *jira.AttachmentSourceError
+		require.True(t, errors.As(err, &sourceErr))
+		assert.Contains(t, sourceErr.Reason, "scheme must be http or https")
+	})
+}
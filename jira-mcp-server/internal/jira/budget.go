@@ -0,0 +1,58 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+// callBudgetKey is the context key under which a *callBudget is stored.
+type callBudgetKey struct{}
+
+// callBudget counts the JIRA API calls made on behalf of one incoming request.
+type callBudget struct {
+	limit int64
+	used  atomic.Int64
+}
+
+// CallBudgetExceededError is returned when a request has already used up the
+// number of JIRA API calls allowed by WithCallBudget. The call is not sent.
+type CallBudgetExceededError struct {
+	Limit int
+}
+
+func (e *CallBudgetExceededError) Error() string {
+	return fmt.Sprintf("JIRA call budget of %d calls per request exceeded", e.Limit)
+}
+
+// WithCallBudget returns a context that allows at most limit JIRA API calls to be
+// made with it (or contexts derived from it). A limit of zero or less means unlimited.
+func WithCallBudget(ctx context.Context, limit int) context.Context {
+	if limit <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, callBudgetKey{}, &callBudget{limit: int64(limit)})
+}
+
+// CallsMade returns the number of JIRA API calls attempted with a context created by
+// WithCallBudget, including any rejected for exceeding the budget. It returns 0 if
+// the context carries no budget.
+func CallsMade(ctx context.Context) int {
+	if b, ok := ctx.Value(callBudgetKey{}).(*callBudget); ok {
+		return int(b.used.Load())
+	}
+	return 0
+}
+
+// spendCallBudget records a JIRA API call against the context's budget, if any,
+// and returns a *CallBudgetExceededError if the budget is already used up.
+func spendCallBudget(ctx context.Context) error {
+	b, ok := ctx.Value(callBudgetKey{}).(*callBudget)
+	if !ok {
+		return nil
+	}
+	if b.used.Add(1) > b.limit {
+		return &CallBudgetExceededError{Limit: int(b.limit)}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package jira_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_CallBudget(t *testing.T) {
+	requests := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// Every page is full, so SearchAllIssues keeps paging until 250 issues are fetched
+		issues := ""
+		for i := 0; i < 100; i++ {
+			if i > 0 {
+				issues += ","
+			}
+			issues += fmt.Sprintf(`{"key":"PROJ-%d"}`, i)
+		}
+		_, _ = fmt.Fprintf(w, `{"startAt":0,"maxResults":100,"total":250,"issues":[%s]}`, issues)
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	ctx := jira.WithCallBudget(context.Background(), 2)
+	_, err := client.SearchAllIssues(ctx, "project = PROJ", nil, 1000)
+
+	var overBudget *jira.CallBudgetExceededError
+	require.ErrorAs(t, err, &overBudget)
+	assert.Equal(t, 2, overBudget.Limit)
+	assert.Equal(t, 2, requests, "The call over budget must not be sent")
+	assert.Equal(t, 3, jira.CallsMade(ctx))
+}
+
+func TestWithCallBudget_Unlimited(t *testing.T) {
+	ctx := jira.WithCallBudget(context.Background(), 0)
+	assert.Equal(t, context.Background(), ctx)
+	assert.Equal(t, 0, jira.CallsMade(ctx))
+}
@@ -0,0 +1,87 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// MaxBulkFetchIssues is the most issues JIRA's bulkfetch endpoint returns per request.
+const MaxBulkFetchIssues = 100
+
+// bulkFetchConcurrency bounds the concurrent GetIssue calls made when bulkfetch is unavailable.
+const bulkFetchConcurrency = 8
+
+// BulkFetchIssues returns the issues with the given keys (or IDs) in one call to
+// POST /rest/api/3/issue/bulkfetch. Issues that do not exist or cannot be viewed
+// are left out of the result. Instances without the endpoint (404) are handled
+// by fetching the issues one by one, concurrently, with the same semantics.
+func (c *Client) BulkFetchIssues(ctx context.Context, keys []string, fields []string) ([]Issue, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one issue key is required")
+	}
+	if len(keys) > MaxBulkFetchIssues {
+		return nil, fmt.Errorf("at most %d issues can be fetched at once", MaxBulkFetchIssues)
+	}
+
+	payload := map[string]interface{}{"issueIdsOrKeys": keys}
+	if len(fields) > 0 {
+		payload["fields"] = fields
+	}
+
+	var raw json.RawMessage
+	err := c.doJSON(ctx, "POST", "/rest/api/3/issue/bulkfetch", payload, &raw)
+	var apiErr *JiraAPIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		c.logger.DebugContext(ctx, "JIRA bulkfetch endpoint not available; fetching issues individually")
+		return c.getIssuesConcurrently(ctx, keys, fields)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Issues []Issue `json:"issues"`
+	}
+	if err := decodeIssueJSON(bytes.NewReader(raw), &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %v", err)
+	}
+	return result.Issues, nil
+}
+
+// getIssuesConcurrently fetches issues with GetIssue, at most bulkFetchConcurrency
+// at a time, returning them in the order of keys. Missing issues are skipped.
+func (c *Client) getIssuesConcurrently(ctx context.Context, keys []string, fields []string) ([]Issue, error) {
+	issues := make([]*Issue, len(keys))
+	errs := make([]error, len(keys))
+	sem := make(chan struct{}, bulkFetchConcurrency)
+
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			issues[i], errs[i] = c.GetIssue(ctx, key, fields)
+		}(i, key)
+	}
+	wg.Wait()
+
+	result := make([]Issue, 0, len(keys))
+	for i, issue := range issues {
+		var apiErr *JiraAPIError
+		if errors.As(errs[i], &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		result = append(result, *issue)
+	}
+	return result, nil
+}
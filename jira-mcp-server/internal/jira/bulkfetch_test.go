@@ -0,0 +1,76 @@
+package jira_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_BulkFetchIssues(t *testing.T) {
+	t.Run("Bulkfetch Endpoint", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "/rest/api/3/issue/bulkfetch", r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"issueIdsOrKeys":["PROJ-1","PROJ-2"],"fields":["summary"]}`, string(body))
+			_, _ = w.Write([]byte(`{
+				"issues": [
+					{"id":"10001","key":"PROJ-1","fields":{"summary":"First"}},
+					{"id":"10002","key":"PROJ-2","fields":{"summary":"Second"}}
+				],
+				"issueErrors": []
+			}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		issues, err := client.BulkFetchIssues(context.Background(), []string{"PROJ-1", "PROJ-2"}, []string{"summary"})
+
+		require.NoError(t, err)
+		require.Len(t, issues, 2)
+		assert.Equal(t, "PROJ-1", issues[0].Key)
+		assert.Equal(t, "Second", issues[1].Fields["summary"])
+	})
+
+	t.Run("Fallback To Single Gets", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/rest/api/3/issue/bulkfetch":
+				w.WriteHeader(http.StatusNotFound)
+			case r.Method == "GET" && r.URL.Path == "/rest/api/3/issue/PROJ-404":
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`))
+			case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/rest/api/3/issue/"):
+				assert.Equal(t, "summary", r.URL.Query().Get("fields"))
+				key := strings.TrimPrefix(r.URL.Path, "/rest/api/3/issue/")
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"key": key, "fields": map[string]string{"summary": "Issue " + key}})
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		keys := []string{"PROJ-1", "PROJ-404"}
+		for i := 2; i <= 12; i++ {
+			keys = append(keys, fmt.Sprintf("PROJ-%d", i))
+		}
+		issues, err := client.BulkFetchIssues(context.Background(), keys, []string{"summary"})
+
+		require.NoError(t, err)
+		require.Len(t, issues, 12, "The missing issue should be skipped")
+		assert.Equal(t, "PROJ-1", issues[0].Key, "Issues should keep the requested order")
+		assert.Equal(t, "PROJ-2", issues[1].Key)
+		assert.Equal(t, "Issue PROJ-12", issues[11].Fields["summary"])
+	})
+}
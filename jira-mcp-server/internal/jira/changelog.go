@@ -0,0 +1,48 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// changelogPageSize is the page size requested from /rest/api/3/issue/{issueKey}/changelog.
+const changelogPageSize = 100
+
+// changelogPage is one page of GET /rest/api/3/issue/{issueKey}/changelog.
+type changelogPage struct {
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	IsLast     bool             `json:"isLast"`
+	Values     []ChangelogEntry `json:"values"`
+}
+
+// GetChangelog returns the full change history of an issue, oldest first, using
+// GET /rest/api/3/issue/{issueKey}/changelog and following its pages until isLast.
+// Unlike the "changelog" expansion of an issue, it is not capped at 100 entries.
+func (c *Client) GetChangelog(ctx context.Context, issueKey string) ([]ChangelogEntry, error) {
+	if issueKey == "" {
+		return nil, fmt.Errorf("issue key cannot be empty")
+	}
+
+	entries := []ChangelogEntry{}
+	path := fmt.Sprintf("/rest/api/3/issue/%s/changelog", url.PathEscape(issueKey))
+	for startAt := 0; ; {
+		query := url.Values{
+			"startAt":    {strconv.Itoa(startAt)},
+			"maxResults": {strconv.Itoa(changelogPageSize)},
+		}
+		var page changelogPage
+		if err := c.doJSON(ctx, "GET", path+"?"+query.Encode(), nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to get changelog of %s at startAt %d: %w", issueKey, startAt, err)
+		}
+		entries = append(entries, page.Values...)
+		// An empty page also ends the listing so a misbehaving server cannot loop us forever
+		if page.IsLast || len(page.Values) == 0 {
+			return entries, nil
+		}
+		startAt += len(page.Values)
+	}
+}
@@ -0,0 +1,75 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_GetChangelog(t *testing.T) {
+	t.Run("Follows Pages Until isLast", func(t *testing.T) {
+		var startAts []string
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "/rest/api/3/issue/PROJ-1/changelog", r.URL.Path)
+			startAts = append(startAts, r.URL.Query().Get("startAt"))
+			if r.URL.Query().Get("startAt") == "0" {
+				_, _ = w.Write([]byte(`{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[
+					{"id":"100","author":{"accountId":"abc","displayName":"Alice","active":true},"created":"2024-03-01T10:00:00.000+0000",
+					 "items":[{"field":"status","fieldtype":"jira","from":"1","fromString":"To Do","to":"3","toString":"In Progress"}]}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[
+				{"id":"101","author":{"accountId":"def","displayName":"Bob","active":true},"created":"2024-03-02T09:30:00.000+0000",
+				 "items":[{"field":"assignee","fieldtype":"jira","from":null,"fromString":null,"to":"abc","toString":"Alice"}]}]}`))
+		})
+		defer server.Close()
+
+		entries, err := client.GetChangelog(context.Background(), "PROJ-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0", "1"}, startAts)
+		assert.Equal(t, []jira.ChangelogEntry{
+			{
+				ID:      "100",
+				Author:  jira.User{AccountID: "abc", DisplayName: "Alice", Active: true},
+				Created: "2024-03-01T10:00:00.000+0000",
+				Items:   []jira.ChangelogItem{{Field: "status", FieldType: "jira", From: "1", FromString: "To Do", To: "3", ToString: "In Progress"}},
+			},
+			{
+				ID:      "101",
+				Author:  jira.User{AccountID: "def", DisplayName: "Bob", Active: true},
+				Created: "2024-03-02T09:30:00.000+0000",
+				Items:   []jira.ChangelogItem{{Field: "assignee", FieldType: "jira", To: "abc", ToString: "Alice"}},
+			},
+		}, entries)
+	})
+
+	t.Run("No History", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":100,"total":0,"isLast":true,"values":[]}`))
+		})
+		defer server.Close()
+
+		entries, err := client.GetChangelog(context.Background(), "PROJ-1")
+		require.NoError(t, err)
+		assert.NotNil(t, entries, "An empty history should encode as [] rather than null")
+		assert.Empty(t, entries)
+	})
+
+	t.Run("Issue Not Found", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+		defer server.Close()
+
+		_, err := client.GetChangelog(context.Background(), "PROJ-404")
+		var apiErr *jira.JiraAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	})
+}
@@ -4,17 +4,29 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
-	// Added for URL parsing in error handling
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"jira-mcp-server/internal/clock"
 )
 
 // EpicLinkFieldName holds the JIRA custom field ID typically used for "Epic Link".
 // NOTE: This ID can vary between JIRA instances. Common values include 'customfield_10014', 'customfield_10008'.
-// Verify the correct ID for your specific JIRA Cloud instance if filtering by Epic Link fails.
+// It is only a fallback for when ResolveEpicLinkField cannot detect the field.
 const EpicLinkFieldName = "customfield_10014"
 
 // JiraService defines the interface for interacting with the JIRA API.
@@ -24,37 +36,236 @@ const EpicLinkFieldName = "customfield_10014"
 // This allows for dependency injection and easier testing.
 type JiraService interface {
 	CreateIssue(ctx context.Context, req CreateIssueRequest) (*CreateIssueResponse, error)
-	SearchIssues(ctx context.Context, jql string, maxResults int, fields []string) (*SearchResponse, error)
+	SearchIssues(ctx context.Context, jql string, startAt, maxResults int, fields []string) (*SearchResponse, error)
+	SearchIssuesWithExpand(ctx context.Context, jql string, startAt, maxResults int, fields []string, expand []string) (*SearchResponse, error)
 	GetIssue(ctx context.Context, issueKey string, fields []string) (*Issue, error)
+	GetCreateMeta(ctx context.Context, projectKey, issueTypeName string) (*CreateMeta, error)
+	GetTransitions(ctx context.Context, issueKey string) ([]Transition, error)
+	AddComment(ctx context.Context, issueKey string, req AddCommentRequest) (*Comment, error)
+	SearchAllIssues(ctx context.Context, jql string, fields []string, limit int) (*SearchResponse, error)
+	RankIssues(ctx context.Context, req RankIssuesRequest) error
+	GetMyPermissions(ctx context.Context, projectKey string, permissionKeys []string) (map[string]Permission, error)
+	LinkIssues(ctx context.Context, inwardKey, outwardKey, linkType string) error
+	GetVotes(ctx context.Context, issueKey string) (*Votes, error)
+	GetWatchers(ctx context.Context, issueKey string) (*Watchers, error)
+	ResolveAccountID(ctx context.Context, email string) (string, error)
+	AssignIssue(ctx context.Context, issueKey, accountID string) error
+	GetFilters(ctx context.Context) ([]Filter, error)
+	GetFilter(ctx context.Context, filterID string) (*Filter, error)
+	AddAttachmentFromURL(ctx context.Context, issueKey, url, filename string) error
+	UpdateIssue(ctx context.Context, issueKey string, req UpdateIssueRequest) error
+	IssueExists(ctx context.Context, issueKey string) (bool, error)
+	DeleteIssue(ctx context.Context, issueKey string, deleteSubtasks bool) error
+	UnassignIssue(ctx context.Context, issueKey string) error
+	BulkFetchIssues(ctx context.Context, keys []string, fields []string) ([]Issue, error)
+	GetUser(ctx context.Context, accountID string) (*User, error)
+	ResolveEpicLinkField(ctx context.Context) (string, error)
+	Ping(ctx context.Context) (*User, error)
+	GetProjects(ctx context.Context) ([]Project, error)
+	GetIssueTypesForProject(ctx context.Context, projectKey string) ([]IssueType, error)
+	GetLinkTypes(ctx context.Context) ([]LinkType, error)
+	GetCurrentUser(ctx context.Context) (*User, error)
+	GetChangelog(ctx context.Context, issueKey string) ([]ChangelogEntry, error)
+	SearchIssuesToken(ctx context.Context, jql string, pageToken string, maxResults int, fields []string) (*SearchTokenResponse, error)
+	SearchAllIssuesToken(ctx context.Context, jql string, fields []string, limit int) (*SearchResponse, error)
+	TransitionIssue(ctx context.Context, issueKey, transitionID string) error
+	TransitionIssueByName(ctx context.Context, issueKey, transitionName string) error
+	UpdateLabels(ctx context.Context, issueKey string, add, remove []string) error
+	AddWatcher(ctx context.Context, issueKey, accountID string) error
+	RemoveWatcher(ctx context.Context, issueKey, accountID string) error
 }
 
+// AuthMode selects how the client authenticates to JIRA.
+type AuthMode string
+
+const (
+	// AuthBasic sends the user's email and API token with HTTP Basic auth (JIRA Cloud).
+	AuthBasic AuthMode = "basic"
+	// AuthBearer sends the token as "Authorization: Bearer", as used by the Personal
+	// Access Tokens of JIRA Server and Data Center. No email is needed, and the base
+	// URL may include the context path JIRA is served under (e.g. https://host/jira).
+	AuthBearer AuthMode = "bearer"
+)
+
+// DefaultAPIVersion is the JIRA REST API version used unless Config.APIVersion is
+// set. JIRA Cloud serves version 3; Server and Data Center only serve version 2.
+const DefaultAPIVersion = 3
+
 // Client implements the JiraService interface and provides methods
 // for interacting with the JIRA Cloud REST API.
 
 type Client struct {
 	baseURL    string
+	authMode   AuthMode
+	apiVersion int
 	userEmail  string
 	apiToken   string
 	httpClient *http.Client
+	clock      clock.Clock
+
+	// logger receives debug logs of request and response bodies, with the values of
+	// maskFields replaced
+	logger     *slog.Logger
+	maskFields map[string]bool
+
+	// host is the JIRA host name used to pick the rate limiter's bucket
+	host        string
+	rateLimiter *HostRateLimiter
+	maxRetries  int
+
+	// requestTimeout bounds each call to JIRA; zero means no per-call limit
+	requestTimeout time.Duration
+	callObserver   CallObserver
+
+	sprintFieldID    string
+	epicLinkFieldID  string
+	epicLinkOnCreate bool
+
+	// attachmentClient downloads AddAttachmentFromURL sources; it has no JIRA credentials
+	attachmentClient       *http.Client
+	attachmentURLHosts     []string
+	attachmentMaxBytes     int64
+	attachmentFetchTimeout time.Duration
+
+	// createMetaCache caches GetCreateMeta results per project and issue type, and
+	// issueTypesCache the issue types ResolveIssueTypeID looks names up in
+	createMetaMu    sync.Mutex
+	createMetaCache map[string]createMetaCacheEntry
+	issueTypesCache map[string]issueTypesCacheEntry
+
+	// textareaFields caches the multi-line text custom fields found by textareaFieldIDs
+	textareaMu           sync.Mutex
+	textareaFields       map[string]bool
+	textareaFieldsExpiry time.Time
+
+	// resolvedEpicLinkField caches the field found by ResolveEpicLinkField, and
+	// epicLinkErr a failed lookup until epicLinkRetryAt
+	epicLinkMu            sync.Mutex
+	resolvedEpicLinkField string
+	epicLinkErr           error
+	epicLinkRetryAt       time.Time
+
+	// userCache caches GetUser results per accountId
+	userMu    sync.Mutex
+	userCache map[string]userCacheEntry
+
+	// issueCache caches GetIssue responses; nil when disabled
+	issueCache *issueCache
+
+	tracer trace.Tracer
 }
 
-// NewClient creates a new JIRA API client.
-// It reads configuration (JIRA_URL, JIRA_USER_EMAIL, JIRA_API_TOKEN) from Viper.
-// An optional custom http.Client can be provided for testing or specific transport configurations;
-// if httpClient is nil, http.DefaultClient will be used.
-// It returns an error if required configuration is missing.
+// Config holds the settings needed to construct a Client.
+type Config struct {
+	BaseURL   string // JIRA instance base URL, e.g. https://your-domain.atlassian.net
+	UserEmail string // Email of the user the API token belongs to; unused with AuthBearer
+	APIToken  string // JIRA API token, or the bearer token with AuthBearer
+
+	// AuthMode is AuthBasic or AuthBearer. Optional; defaults to AuthBasic.
+	AuthMode AuthMode
+
+	// APIVersion is the REST API version to call: 3, or 2 for JIRA Server and Data
+	// Center. With version 2, rich text (descriptions, environments, comments) is
+	// sent as plain text instead of ADF. Optional; defaults to DefaultAPIVersion.
+	APIVersion int
+
+	// SprintFieldID is the custom field holding an issue's sprints.
+	// Optional; defaults to DefaultSprintFieldName.
+	SprintFieldID string
+
+	// EpicLinkFieldID is the "Epic Link" custom field of classic projects.
+	// Optional; if empty it is detected by ResolveEpicLinkField.
+	EpicLinkFieldID string
+
+	// EpicLinkOnCreate makes CreateIssue set CreateIssueRequest.EpicKey through
+	// EpicLinkFieldID (classic projects) instead of as the issue's parent
+	// (team-managed projects).
+	EpicLinkOnCreate bool
+
+	// Clock is the time source used for cache expiry and other time-based behavior.
+	// Optional; defaults to the real clock. Tests can inject a clock.Fake.
+	Clock clock.Clock
+
+	// RateLimiter throttles calls to the JIRA host. Optional; nil means unlimited.
+	RateLimiter *HostRateLimiter
+
+	// MaxRetries is how many times a request answered with 429 Too Many Requests is
+	// retried, honoring JIRA's Retry-After header. Optional; zero disables retries.
+	MaxRetries int
+
+	// RequestTimeout bounds each individual call to JIRA, including reading the
+	// response. Optional; zero means calls are only bounded by their context.
+	RequestTimeout time.Duration
+
+	// CallObserver is notified of every call to JIRA, e.g. to count them in metrics.
+	// Optional.
+	CallObserver CallObserver
+
+	// Logger receives debug logs of create payloads and issue responses.
+	// Optional; bodies are not logged if nil.
+	Logger *slog.Logger
+
+	// LogMaskFields are field IDs or keys (e.g. "customfield_10050") whose values
+	// are replaced with "***" in logged bodies.
+	LogMaskFields []string
+
+	// AttachmentURLHosts are the hosts AddAttachmentFromURL may download from,
+	// as "host" or "host:port". Optional; if empty, every URL is rejected.
+	AttachmentURLHosts []string
 
-// NewClient creates a new JIRA API client.
-// It reads configuration from environment variables (JIRA_URL, JIRA_USER_EMAIL, JIRA_API_TOKEN).
+	// AttachmentMaxBytes and AttachmentFetchTimeout limit AddAttachmentFromURL downloads.
+	// Optional; default to DefaultAttachmentMaxBytes and DefaultAttachmentFetchTimeout.
+	AttachmentMaxBytes     int64
+	AttachmentFetchTimeout time.Duration
+
+	// IssueCacheTTL is how long GetIssue responses are reused. Issues changed through
+	// the client are dropped from the cache immediately; changes made elsewhere show
+	// up once the entry expires. Optional; zero disables the cache.
+	IssueCacheTTL time.Duration
+
+	// IssueCacheObserver is notified of issue cache hits and misses. Optional.
+	IssueCacheObserver IssueCacheObserver
+
+	// TracerProvider creates the spans recorded for calls to JIRA.
+	// Optional; defaults to the global OpenTelemetry tracer provider.
+	TracerProvider trace.TracerProvider
+}
+
+// NewClient creates a new JIRA API client from explicit configuration values,
+// typically loaded via Viper in main.
 // An optional custom http.Client can be provided for testing or specific transport configurations.
 // If httpClient is nil, http.DefaultClient will be used.
-func NewClient(httpClient *http.Client) (*Client, error) {
-	baseURL := os.Getenv("JIRA_URL")
-	userEmail := os.Getenv("JIRA_USER_EMAIL")
-	apiToken := os.Getenv("JIRA_API_TOKEN")
+// It returns an error if any of the required values are empty.
+func NewClient(cfg Config, httpClient *http.Client) (*Client, error) {
+	authMode := cfg.AuthMode
+	if authMode == "" {
+		authMode = AuthBasic
+	}
+	switch authMode {
+	case AuthBasic:
+		if cfg.BaseURL == "" || cfg.UserEmail == "" || cfg.APIToken == "" {
+			return nil, fmt.Errorf("missing required JIRA credentials (JIRA_URL, JIRA_USER_EMAIL, JIRA_API_TOKEN)")
+		}
+	case AuthBearer:
+		if cfg.BaseURL == "" || cfg.APIToken == "" {
+			return nil, fmt.Errorf("missing required JIRA credentials (JIRA_URL, JIRA_API_TOKEN)")
+		}
+	default:
+		return nil, fmt.Errorf("invalid auth mode %q: expected %s or %s", authMode, AuthBasic, AuthBearer)
+	}
 
-	if baseURL == "" || userEmail == "" || apiToken == "" {
-		return nil, fmt.Errorf("missing required JIRA credentials in environment variables (JIRA_URL, JIRA_USER_EMAIL, JIRA_API_TOKEN)")
+	apiVersion := cfg.APIVersion
+	if apiVersion == 0 {
+		apiVersion = DefaultAPIVersion
+	}
+	if apiVersion != 2 && apiVersion != 3 {
+		return nil, fmt.Errorf("invalid API version %d: expected 2 or 3", apiVersion)
+	}
+
+	// Server and Data Center are often served under a context path; Cloud never is
+	baseURL, err := normalizeBaseURL(cfg.BaseURL, authMode == AuthBearer)
+	if err != nil {
+		return nil, err
 	}
 
 	client := httpClient
@@ -62,34 +273,224 @@ func NewClient(httpClient *http.Client) (*Client, error) {
 		client = http.DefaultClient // Use default client if none provided
 	}
 
-	return &Client{
+	sprintFieldID := cfg.SprintFieldID
+	if sprintFieldID == "" {
+		sprintFieldID = DefaultSprintFieldName
+	}
+
+	clk := cfg.Clock
+	if clk == nil {
+		clk = clock.Real{}
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JIRA_URL %q: %v", cfg.BaseURL, err)
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.New(slog.NewJSONHandler(io.Discard, nil))
+	}
+	maskFields := make(map[string]bool, len(cfg.LogMaskFields))
+	for _, field := range cfg.LogMaskFields {
+		maskFields[field] = true
+	}
+
+	attachmentMaxBytes := cfg.AttachmentMaxBytes
+	if attachmentMaxBytes <= 0 {
+		attachmentMaxBytes = DefaultAttachmentMaxBytes
+	}
+	attachmentFetchTimeout := cfg.AttachmentFetchTimeout
+	if attachmentFetchTimeout <= 0 {
+		attachmentFetchTimeout = DefaultAttachmentFetchTimeout
+	}
+
+	tracerProvider := cfg.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+
+	c := &Client{
 		baseURL:    baseURL,
-		userEmail:  userEmail,
-		apiToken:   apiToken,
+		authMode:   authMode,
+		apiVersion: apiVersion,
+		userEmail:  cfg.UserEmail,
+		apiToken:   cfg.APIToken,
 		httpClient: client,
-	}, nil
+		clock:      clk,
+
+		logger:     logger,
+		maskFields: maskFields,
+
+		host:        u.Host,
+		rateLimiter: cfg.RateLimiter,
+		maxRetries:  cfg.MaxRetries,
+
+		requestTimeout: cfg.RequestTimeout,
+		callObserver:   cfg.CallObserver,
+
+		sprintFieldID:    sprintFieldID,
+		epicLinkFieldID:  cfg.EpicLinkFieldID,
+		epicLinkOnCreate: cfg.EpicLinkOnCreate,
+
+		attachmentURLHosts:     cfg.AttachmentURLHosts,
+		attachmentMaxBytes:     attachmentMaxBytes,
+		attachmentFetchTimeout: attachmentFetchTimeout,
+
+		issueCache: newIssueCache(cfg.IssueCacheTTL, DefaultIssueCacheSize, clk, cfg.IssueCacheObserver),
+
+		tracer: tracerProvider.Tracer(tracerName),
+	}
+	c.attachmentClient = &http.Client{CheckRedirect: c.checkAttachmentRedirect}
+	return c, nil
+}
+
+// setAuth sets httpReq's Authorization header for the client's auth mode.
+func (c *Client) setAuth(httpReq *http.Request) {
+	if c.authMode == AuthBearer {
+		httpReq.Header.Set("Authorization", "Bearer "+c.apiToken)
+		return
+	}
+	httpReq.SetBasicAuth(c.userEmail, c.apiToken)
+}
+
+// normalizeBaseURL validates the configured JIRA base URL and strips any trailing slash,
+// so that request URLs built as baseURL + "/rest/api/3/..." never contain "//".
+// The URL must be absolute, use the http or https scheme, and must not contain a query
+// or fragment, nor a path unless allowPath is set.
+func normalizeBaseURL(raw string, allowPath bool) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", fmt.Errorf("invalid JIRA_URL %q: %v", raw, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("invalid JIRA_URL %q: scheme must be http or https (e.g. https://your-domain.atlassian.net)", raw)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid JIRA_URL %q: missing host", raw)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("invalid JIRA_URL %q: must not contain a query or fragment", raw)
+	}
+	path := strings.TrimRight(u.EscapedPath(), "/")
+	if path != "" && !allowPath {
+		return "", fmt.Errorf("invalid JIRA_URL %q: must not contain a path (a context path is only supported with the bearer auth mode)", raw)
+	}
+	return u.Scheme + "://" + u.Host + path, nil
+}
+
+// requestURL returns the URL of the API resource at path, which is given in its
+// REST API v3 form ("/rest/api/3/..."), for the configured API version.
+func (c *Client) requestURL(path string) string {
+	return c.baseURL + c.versionedPath(path)
+}
+
+// versionedPath rewrites a "/rest/api/3/..." path for the configured API version.
+// Other paths, e.g. of the Agile API, are returned unchanged.
+func (c *Client) versionedPath(path string) string {
+	if c.apiVersion != 3 {
+		if rest, ok := strings.CutPrefix(path, "/rest/api/3/"); ok {
+			return fmt.Sprintf("/rest/api/%d/%s", c.apiVersion, rest)
+		}
+		if path == "/rest/api/3" {
+			return fmt.Sprintf("/rest/api/%d", c.apiVersion)
+		}
+	}
+	return path
+}
+
+// restPath maps the path of a request URL back to its REST API v3 form relative to
+// the base URL, so that calls are classified alike whatever the API version and
+// context path.
+func (c *Client) restPath(urlPath string) string {
+	if u, err := url.Parse(c.baseURL); err == nil {
+		urlPath = strings.TrimPrefix(urlPath, u.Path)
+	}
+	if rest, ok := strings.CutPrefix(urlPath, fmt.Sprintf("/rest/api/%d/", c.apiVersion)); ok {
+		return "/rest/api/3/" + rest
+	}
+	return urlPath
+}
+
+// richText returns text in the given format as the value of a rich text field:
+// an ADF document for API v3, or the text as is for API v2, which takes wiki
+// markup strings.
+func (c *Client) richText(text, format string) interface{} {
+	if c.apiVersion == 2 {
+		return text
+	}
+	return richTextToADF(text, format)
+}
+
+// NewClientFromEnv is a convenience constructor that reads the configuration from the
+// JIRA_URL, JIRA_USER_EMAIL and JIRA_API_TOKEN environment variables and calls NewClient.
+func NewClientFromEnv(httpClient *http.Client) (*Client, error) {
+	return NewClient(Config{
+		BaseURL:   os.Getenv("JIRA_URL"),
+		UserEmail: os.Getenv("JIRA_USER_EMAIL"),
+		APIToken:  os.Getenv("JIRA_API_TOKEN"),
+	}, httpClient)
 }
 
 // CreateIssueRequest defines the structure for the request body when creating a JIRA issue.
 // It includes required fields like ProjectKey, Summary, IssueType, and optional fields.
 
 type CreateIssueRequest struct {
-	ProjectKey    string `json:"project_key"`
-	Summary       string `json:"summary"`
-	IssueType     string `json:"issue_type"`
-	Description   string `json:"description,omitempty"`
-	AssigneeEmail string `json:"assignee_email,omitempty"`
-	ParentKey     string `json:"parent_key,omitempty"`
+	ProjectKey  string `json:"project_key" jsonschema:"required" description:"Key of the project, e.g. PROJ"`
+	Summary     string `json:"summary" jsonschema:"required"`
+	IssueType   string `json:"issue_type" jsonschema:"required" description:"Issue type name or ID, e.g. Bug or Story"`
+	Description string `json:"description,omitempty"`
+	Environment string `json:"environment,omitempty"`
+	ParentKey   string `json:"parent_key,omitempty"`
+
+	// DescriptionFormat is the format of Description: TextFormatPlain (the
+	// default) or TextFormatMarkdown, which converts headings, emphasis, lists
+	// and code into the equivalent ADF nodes.
+	DescriptionFormat string `json:"description_format,omitempty" description:"Format of description: plain (default) or markdown"`
+
+	// EpicKey places the new issue under an epic: via "parent" in team-managed
+	// projects, or via the Epic Link custom field in classic projects when the
+	// client is configured with EpicLinkOnCreate. ParentKey is for subtasks.
+	EpicKey string `json:"epic_key,omitempty"`
+
+	// AssigneeAccountID or AssigneeEmail (resolved with ResolveAccountID) name the
+	// user to assign the issue to after it has been created. CreateIssue itself
+	// ignores them; see AssignIssue.
+	AssigneeAccountID string `json:"assignee_account_id,omitempty"`
+	AssigneeEmail     string `json:"assignee_email,omitempty"`
+
+	// CustomFields are merged into the JIRA "fields" payload as given, e.g.
+	// {"priority": {"name": "High"}, "customfield_10020": 5}, except that plain
+	// strings for multi-line text custom fields are converted to ADF. The fields set
+	// by the other members (project, summary, issuetype, ...) take precedence.
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+
+	// Properties are entity properties set on the issue as part of creation,
+	// typically used by Marketplace apps to store their own data.
+	Properties []EntityProperty `json:"properties,omitempty"`
+}
+
+// EntityProperty is a key/value pair stored on a JIRA entity. Value may be any JSON value.
+type EntityProperty struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
 }
 
 // CreateIssueResponse defines the structure for the successful response body
 // when creating a JIRA issue, containing the new issue's Key and Self URL.
 
 type CreateIssueResponse struct {
+	ID   string `json:"id"` // Numeric issue ID, as a string
 	Key  string `json:"key"`
 	Self string `json:"self"`
 }
 
+// BrowseURL returns the human-facing web URL of the created issue, derived from its REST Self URL.
+func (r *CreateIssueResponse) BrowseURL() string {
+	return browseURL(r.Self, r.Key)
+}
+
 // SearchResponse represents the structure of the response from JIRA's /rest/api/3/search endpoint,
 // containing pagination details and a slice of found Issues.
 
@@ -100,6 +501,11 @@ type SearchResponse struct {
 	MaxResults int     `json:"maxResults"`
 	Total      int     `json:"total"`
 	Issues     []Issue `json:"issues"`
+
+	// NextPageToken and IsLast are only set for a page of a token search (see
+	// SearchIssuesToken), which reports no startAt or total.
+	NextPageToken string `json:"nextPageToken,omitempty"`
+	IsLast        *bool  `json:"isLast,omitempty"`
 }
 
 // Issue represents a simplified structure for a JIRA issue, commonly returned in search results
@@ -107,11 +513,72 @@ type SearchResponse struct {
 
 // Issue represents a JIRA issue with common fields
 type Issue struct {
-	Expand string                 `json:"expand"`
-	ID     string                 `json:"id"`
-	Key    string                 `json:"key"`
-	Self   string                 `json:"self"`
-	Fields map[string]interface{} `json:"fields"`
+	Expand    string                 `json:"expand"`
+	ID        string                 `json:"id"`
+	Key       string                 `json:"key"`
+	Self      string                 `json:"self,omitempty"`
+	Fields    map[string]interface{} `json:"fields"`
+	Changelog *Changelog             `json:"changelog,omitempty"` // Only populated when "changelog" is expanded
+	Sprints   []SprintRef            `json:"sprints,omitempty"`   // Parsed from the sprint custom field, if present
+	Votes     *Votes                 `json:"votes,omitempty"`     // Only populated when requested via ?include=votes
+	Watchers  *Watchers              `json:"watchers,omitempty"`  // Only populated when requested via ?include=watchers
+}
+
+// BrowseURL returns the human-facing web URL of the issue (e.g.
+// https://your-domain.atlassian.net/browse/PROJ-1), derived from its REST Self URL.
+// It returns an empty string if Self is missing or malformed.
+func (i *Issue) BrowseURL() string {
+	return browseURL(i.Self, i.Key)
+}
+
+// browseURL builds scheme://host/browse/KEY from a REST self URL, keeping the
+// context path JIRA is served under, if any.
+func browseURL(self, key string) string {
+	u, err := url.Parse(self)
+	if err != nil || u.Scheme == "" || u.Host == "" || key == "" {
+		return ""
+	}
+	contextPath, _, _ := strings.Cut(u.EscapedPath(), "/rest/")
+	return u.Scheme + "://" + u.Host + contextPath + "/browse/" + key
+}
+
+// Changelog represents the change history of an issue as returned when the
+// "changelog" expansion is requested.
+type Changelog struct {
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	Histories  []ChangelogEntry `json:"histories"`
+}
+
+// ChangelogEntry represents a single change set in an issue's history,
+// made by one author at one point in time and touching one or more fields.
+type ChangelogEntry struct {
+	ID      string          `json:"id"`
+	Author  User            `json:"author"`
+	Created string          `json:"created"`
+	Items   []ChangelogItem `json:"items"`
+}
+
+// ChangelogItem describes the change of a single field within a ChangelogEntry.
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+// ChangelogTimeLayout is the timestamp layout JIRA uses for changelog "created" values.
+const ChangelogTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// User represents a JIRA user account.
+type User struct {
+	AccountID    string `json:"accountId"`
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress,omitempty"`
+	Active       bool   `json:"active"`
 }
 
 // JiraAPIError represents an error returned specifically from the JIRA API.
@@ -123,12 +590,112 @@ type JiraAPIError struct {
 	StatusCode int
 	Message    string // Raw error message or body from JIRA
 	URL        string // The URL that caused the error
+
+	// ErrorMessages and FieldErrors are parsed from JIRA's error envelope,
+	// {"errorMessages": [...], "errors": {"field": "message"}}, if the body has one.
+	ErrorMessages []string
+	FieldErrors   map[string]string
 }
 
 func (e *JiraAPIError) Error() string {
 	return fmt.Sprintf("JIRA API error: status %d, message: %s (URL: %s)", e.StatusCode, e.Message, e.URL)
 }
 
+// Details joins ErrorMessages and FieldErrors (sorted by field) into one line, e.g.
+// "Issue type is invalid; priority: Field 'priority' is required". It is empty if
+// the body had no error envelope. Unlike Message, it never contains the raw body.
+func (e *JiraAPIError) Details() string {
+	parts := make([]string, 0, len(e.ErrorMessages)+len(e.FieldErrors))
+	for _, msg := range e.ErrorMessages {
+		if msg != "" {
+			parts = append(parts, msg)
+		}
+	}
+	fields := make([]string, 0, len(e.FieldErrors))
+	for field := range e.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		parts = append(parts, fmt.Sprintf("%s: %s", field, e.FieldErrors[field]))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// newJiraAPIError reads a non-2xx response's body into a *JiraAPIError, parsing
+// JIRA's error envelope when present.
+func newJiraAPIError(resp *http.Response, requestURL string) *JiraAPIError {
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	apiErr := &JiraAPIError{
+		StatusCode: resp.StatusCode,
+		Message:    string(bodyBytes),
+		URL:        requestURL,
+	}
+	var envelope struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	}
+	if json.Unmarshal(bodyBytes, &envelope) == nil {
+		apiErr.ErrorMessages = envelope.ErrorMessages
+		if len(envelope.Errors) > 0 {
+			apiErr.FieldErrors = envelope.Errors
+		}
+	}
+	return apiErr
+}
+
+// issueTypeRef references an issue type by ID if issueType is numeric, otherwise by name.
+// Referencing by ID avoids depending on the language issue type names are localized in.
+func issueTypeRef(issueType string) map[string]string {
+	if _, err := strconv.Atoi(issueType); err == nil {
+		return map[string]string{"id": issueType}
+	}
+	return map[string]string{"name": issueType}
+}
+
+// resolveIssueTypeRef returns the issuetype reference for an issue in projectKey.
+// A name is resolved to the ID of the project's matching issue type, so that it
+// works in whatever language JIRA uses for the configured user. A name that is not
+// one of the project's issue types yields an *IssueTypeNotFoundError. If the issue
+// types cannot be listed, the name is sent as given and JIRA decides.
+func (c *Client) resolveIssueTypeRef(ctx context.Context, projectKey, issueType string) (map[string]string, error) {
+	if _, err := strconv.Atoi(issueType); err == nil {
+		return issueTypeRef(issueType), nil
+	}
+	id, err := c.ResolveIssueTypeID(ctx, projectKey, issueType)
+	var notFound *IssueTypeNotFoundError
+	if errors.As(err, &notFound) {
+		return nil, err
+	}
+	if err != nil {
+		c.logger.WarnContext(ctx, "Failed to resolve issue type name; sending it as given", "project", projectKey, "issueType", issueType, "error", err)
+		return issueTypeRef(issueType), nil
+	}
+	return map[string]string{"id": id}, nil
+}
+
+// LoginPageError is returned when JIRA answers a successful status code with an HTML
+// page instead of JSON. This typically means the credentials were rejected and JIRA
+// (or an SSO proxy in front of it) redirected the request to a login page.
+type LoginPageError struct {
+	ContentType string
+	URL         string
+}
+
+func (e *LoginPageError) Error() string {
+	return fmt.Sprintf("JIRA returned an HTML page (Content-Type %q) instead of JSON; check the credentials (URL: %s)", e.ContentType, e.URL)
+}
+
+// checkNotLoginPage returns a *LoginPageError if a 2xx response carries an HTML body.
+func checkNotLoginPage(resp *http.Response, requestURL string) error {
+	contentType := resp.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "text/html" || mediaType == "application/xhtml+xml" {
+		return &LoginPageError{ContentType: contentType, URL: requestURL}
+	}
+	return nil
+}
+
 // CreateIssue sends a request to the JIRA API to create a new issue.
 // It validates required fields in the CreateIssueRequest, constructs the API payload
 // (including handling the description format), and sends an authenticated POST request.
@@ -139,47 +706,66 @@ func (c *Client) CreateIssue(ctx context.Context, req CreateIssueRequest) (*Crea
 	if req.ProjectKey == "" || req.Summary == "" || req.IssueType == "" {
 		return nil, fmt.Errorf("project_key, summary, and issue_type are required")
 	}
+	if !IsTextFormat(req.DescriptionFormat) {
+		return nil, fmt.Errorf("unsupported description format %q", req.DescriptionFormat)
+	}
+
+	if req.ParentKey != "" {
+		// The parent's cached subtasks would no longer list every subtask
+		defer c.issueCache.invalidate(req.ParentKey)
+	}
+
+	// Issue type names are localized, so a name is resolved to its ID first
+	issueType, err := c.resolveIssueTypeRef(ctx, req.ProjectKey, req.IssueType)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct the JIRA API payload using the fields from the request struct
 	fields := map[string]interface{}{
 		"project":   map[string]string{"key": req.ProjectKey},
 		"summary":   req.Summary,
-		"issuetype": map[string]string{"name": req.IssueType},
+		"issuetype": issueType,
 	}
 
 	// Add optional fields if provided
 	if req.Description != "" {
-		// JIRA description often expects a specific document format (Atlassian Document Format)
-		// For simplicity here, we'll send it as a plain string, but a real implementation
-		// might need to structure it correctly.
-		// Example for plain text (might not render correctly in newer JIRA versions):
-		// fields["description"] = req.Description
-		// Example for ADF:
-		fields["description"] = map[string]interface{}{
-			"type":    "doc",
-			"version": 1,
-			"content": []map[string]interface{}{
-				{
-					"type": "paragraph",
-					"content": []map[string]interface{}{
-						{
-							"type": "text",
-							"text": req.Description,
-						},
-					},
-				},
-			},
-		}
+		// JIRA Cloud (API v3) expects descriptions in Atlassian Document Format (ADF).
+		// Blank-line separated blocks are converted into separate paragraphs, or
+		// Markdown into the matching ADF nodes.
+		fields["description"] = c.richText(req.Description, req.DescriptionFormat)
+	}
+	if req.Environment != "" {
+		// Environment is a rich text field too, so it gets the same ADF treatment
+		fields["environment"] = c.richText(req.Environment, TextFormatPlain)
 	}
-	// Assignee logic was removed as email assignment is less reliable and account ID is preferred.
-	// If needed, re-add logic here using account ID.
+	// The assignee is not part of the payload: handlers assign the issue in a
+	// separate AssignIssue call after creation, so that a bad assignee cannot
+	// prevent the issue from being created.
 	if req.ParentKey != "" {
 		fields["parent"] = map[string]string{"key": req.ParentKey}
 	}
+	if req.EpicKey != "" {
+		if c.epicLinkOnCreate {
+			// Classic projects link stories to epics through a custom field holding the key
+			fields[c.epicLinkField(ctx)] = req.EpicKey
+		} else {
+			fields["parent"] = map[string]string{"key": req.EpicKey}
+		}
+	}
+	for key, value := range c.convertTextareaFields(ctx, req.CustomFields) {
+		if _, known := fields[key]; !known {
+			fields[key] = value
+		}
+	}
 
 	payload := map[string]interface{}{
 		"fields": fields,
 	}
+	if len(req.Properties) > 0 {
+		// Properties are a sibling of "fields", not a field themselves
+		payload["properties"] = req.Properties
+	}
 
 	// Marshal payload to JSON
 	jsonPayload, err := json.Marshal(payload)
@@ -188,7 +774,8 @@ func (c *Client) CreateIssue(ctx context.Context, req CreateIssueRequest) (*Crea
 	}
 
 	// Create HTTP request
-	url := fmt.Sprintf("%s/rest/api/3/issue", c.baseURL)
+	url := c.requestURL("/rest/api/3/issue")
+	c.logBody(ctx, "Sending JIRA create payload", url, jsonPayload)
 	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %v", err)
@@ -197,23 +784,22 @@ func (c *Client) CreateIssue(ctx context.Context, req CreateIssueRequest) (*Crea
 	// Set headers
 	httpReq.Header.Set("Content-Type", "application/json")
 	httpReq.Header.Set("Accept", "application/json")
-	httpReq.SetBasicAuth(c.userEmail, c.apiToken)
+	c.setAuth(httpReq)
 
 	// Send request
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.send(ctx, httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request to JIRA API: %v", err)
+		return nil, fmt.Errorf("failed to send request to JIRA API: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Check response status
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 { // Check for non-2xx status
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, &JiraAPIError{
-			StatusCode: resp.StatusCode,
-			Message:    string(bodyBytes),
-			URL:        url, // Use the request URL
-		}
+		return nil, newJiraAPIError(resp, url)
+	}
+
+	if err := checkNotLoginPage(resp, httpReq.URL.String()); err != nil {
+		return nil, err
 	}
 
 	// Parse successful response
@@ -225,11 +811,64 @@ func (c *Client) CreateIssue(ctx context.Context, req CreateIssueRequest) (*Crea
 }
 
 // SearchIssues sends a request to the JIRA API's search endpoint (/rest/api/3/search).
-// It takes a JQL query string, maximum results count, and optional fields list.
+// It takes a JQL query string, the zero-based index of the first result, maximum results
+// count, and optional fields list.
 // It returns a SearchResponse containing the matching issues or an error (potentially a JiraAPIError).
 
 // SearchIssues searches for JIRA issues using JQL query
-func (c *Client) SearchIssues(ctx context.Context, jql string, maxResults int, fields []string) (*SearchResponse, error) {
+func (c *Client) SearchIssues(ctx context.Context, jql string, startAt, maxResults int, fields []string) (*SearchResponse, error) {
+	return c.search(ctx, jql, startAt, maxResults, fields, nil)
+}
+
+// SearchIssuesWithExpand behaves like SearchIssues but additionally asks JIRA to
+// expand the given entities (e.g. "changelog") on every returned issue.
+func (c *Client) SearchIssuesWithExpand(ctx context.Context, jql string, startAt, maxResults int, fields []string, expand []string) (*SearchResponse, error) {
+	return c.search(ctx, jql, startAt, maxResults, fields, expand)
+}
+
+// searchAllPageSize is the page size used by SearchAllIssues.
+const searchAllPageSize = 100
+
+// ResultSetTooLargeError is returned by SearchAllIssues when the query matches more
+// issues than the caller is willing to fetch.
+type ResultSetTooLargeError struct {
+	Total int // Number of issues matching the query
+	Limit int // Maximum number of issues the caller allowed
+}
+
+func (e *ResultSetTooLargeError) Error() string {
+	return fmt.Sprintf("result set too large: %d issues match, limit is %d", e.Total, e.Limit)
+}
+
+// SearchAllIssues fetches every issue matching the JQL query by paging through the
+// search endpoint. If the first page reports more than limit matches, it returns a
+// *ResultSetTooLargeError without fetching further pages. A limit of zero means no limit.
+func (c *Client) SearchAllIssues(ctx context.Context, jql string, fields []string, limit int) (*SearchResponse, error) {
+	first, err := c.search(ctx, jql, 0, searchAllPageSize, fields, nil)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && first.Total > limit {
+		return nil, &ResultSetTooLargeError{Total: first.Total, Limit: limit}
+	}
+
+	all := first
+	for len(all.Issues) < all.Total {
+		page, err := c.search(ctx, jql, len(all.Issues), searchAllPageSize, fields, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(page.Issues) == 0 {
+			break // Guard against the total shrinking while paging
+		}
+		all.Issues = append(all.Issues, page.Issues...)
+	}
+	all.MaxResults = len(all.Issues)
+	return all, nil
+}
+
+// search sends a single request to the JIRA search endpoint (/rest/api/3/search).
+func (c *Client) search(ctx context.Context, jql string, startAt, maxResults int, fields []string, expand []string) (*SearchResponse, error) {
 	if jql == "" {
 		return nil, fmt.Errorf("JQL query cannot be empty")
 	}
@@ -240,9 +879,16 @@ func (c *Client) SearchIssues(ctx context.Context, jql string, maxResults int, f
 		"maxResults": maxResults,
 	}
 
+	if startAt > 0 {
+		payload["startAt"] = startAt
+	}
+
 	if len(fields) > 0 {
 		payload["fields"] = fields
 	}
+	if len(expand) > 0 {
+		payload["expand"] = expand
+	}
 
 	// Marshal payload to JSON
 	jsonPayload, err := json.Marshal(payload)
@@ -251,7 +897,7 @@ func (c *Client) SearchIssues(ctx context.Context, jql string, maxResults int, f
 	}
 
 	// Create HTTP request
-	url := fmt.Sprintf("%s/rest/api/3/search", c.baseURL)
+	url := c.requestURL("/rest/api/3/search")
 	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create search request: %v", err)
@@ -260,30 +906,32 @@ func (c *Client) SearchIssues(ctx context.Context, jql string, maxResults int, f
 	// Set headers
 	httpReq.Header.Set("Content-Type", "application/json")
 	httpReq.Header.Set("Accept", "application/json")
-	httpReq.SetBasicAuth(c.userEmail, c.apiToken)
+	c.setAuth(httpReq)
 
 	// Send request
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.send(ctx, httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send search request: %v", err)
+		return nil, fmt.Errorf("failed to send search request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Check response status
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 { // Check for non-2xx status
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, &JiraAPIError{
-			StatusCode: resp.StatusCode,
-			Message:    string(bodyBytes),
-			URL:        url, // Use the request URL
-		}
+		return nil, newJiraAPIError(resp, url)
+	}
+
+	if err := checkNotLoginPage(resp, httpReq.URL.String()); err != nil {
+		return nil, err
 	}
 
 	// Parse successful response
 	var searchResponse SearchResponse
-	if err := json.NewDecoder(resp.Body).Decode(&searchResponse); err != nil {
+	if err := decodeIssueJSON(resp.Body, &searchResponse); err != nil {
 		return nil, fmt.Errorf("failed to decode search response: %v", err)
 	}
+	for i := range searchResponse.Issues {
+		c.populateSprints(&searchResponse.Issues[i])
+	}
 	return &searchResponse, nil
 }
 
@@ -297,55 +945,150 @@ func (c *Client) GetIssue(ctx context.Context, issueKey string, fields []string)
 		return nil, fmt.Errorf("issue key cannot be empty")
 	}
 
-	// Construct URL
-	url := fmt.Sprintf("%s/rest/api/3/issue/%s", c.baseURL, issueKey)
+	cacheKey := issueCacheKey(issueKey, fields)
+	if body, ok := c.issueCache.get(cacheKey); ok {
+		return c.decodeIssue(body)
+	}
+
+	// Construct URL, escaping the key and fields so unusual values cannot break it
+	issueURL := c.requestURL("/rest/api/3/issue/" + url.PathEscape(issueKey))
 
 	// Add fields query parameter if specified
 	if len(fields) > 0 {
-		url = fmt.Sprintf("%s?fields=%s", url, fieldsCommaSeparated(fields))
+		query := url.Values{"fields": {fieldsCommaSeparated(fields)}}
+		issueURL += "?" + query.Encode()
 	}
 
 	// Create HTTP request
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", issueURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %v", err)
 	}
 
 	// Set headers
 	httpReq.Header.Set("Accept", "application/json")
-	httpReq.SetBasicAuth(c.userEmail, c.apiToken)
+	c.setAuth(httpReq)
 
 	// Send request
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.send(ctx, httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %v", err)
+		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Check response status
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 { // Check for non-2xx status
-		bodyBytes, _ := io.ReadAll(resp.Body)
 		// Attempt to get the original URL from the request if available
-		requestURL := url // Default to the constructed URL
+		requestURL := issueURL // Default to the constructed URL
 		if httpReq != nil && httpReq.URL != nil {
 			requestURL = httpReq.URL.String()
 		}
-		return nil, &JiraAPIError{
-			StatusCode: resp.StatusCode,
-			Message:    string(bodyBytes),
-			URL:        requestURL,
-		}
+		return nil, newJiraAPIError(resp, requestURL)
+	}
+
+	if err := checkNotLoginPage(resp, httpReq.URL.String()); err != nil {
+		return nil, err
 	}
 
 	// Parse successful response
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %v", err)
+	}
+	c.logBody(ctx, "Received JIRA issue response", httpReq.URL.String(), bodyBytes)
+
+	issue, err := c.decodeIssue(bodyBytes)
+	if err != nil {
+		return nil, err
+	}
+	c.issueCache.put(cacheKey, issueKey, bodyBytes)
+	return issue, nil
+}
+
+// decodeIssue decodes a GetIssue response body.
+func (c *Client) decodeIssue(body []byte) (*Issue, error) {
 	var issue Issue
-	if err := json.NewDecoder(resp.Body).Decode(&issue); err != nil {
+	if err := decodeIssueJSON(bytes.NewReader(body), &issue); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %v", err)
 	}
-
+	c.populateSprints(&issue)
 	return &issue, nil
 }
 
+// decodeIssueJSON decodes a response containing issues. Numbers are kept as
+// json.Number so that large numeric custom field values (e.g. IDs beyond 2^53)
+// survive decoding and re-encoding without losing precision.
+func decodeIssueJSON(r io.Reader, out interface{}) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return dec.Decode(out)
+}
+
+// populateSprints fills issue.Sprints from the configured sprint custom field, if present.
+func (c *Client) populateSprints(issue *Issue) {
+	if raw, ok := issue.Fields[c.sprintFieldID]; ok {
+		issue.Sprints = ParseSprints(raw)
+	}
+}
+
+// doJSON sends an authenticated request to the JIRA REST API and decodes the JSON response.
+// path is relative to the base URL (e.g. "/rest/api/3/myself") and may include a query string.
+// If payload is non-nil it is marshalled as the JSON request body; if out is nil the
+// response body is discarded. Non-2xx responses are returned as a *JiraAPIError.
+func (c *Client) doJSON(ctx context.Context, method, path string, payload interface{}, out interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		jsonPayload, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request payload: %v", err)
+		}
+		body = bytes.NewBuffer(jsonPayload)
+	}
+
+	requestURL := c.requestURL(path)
+	httpReq, err := http.NewRequestWithContext(ctx, method, requestURL, body)
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %v", err)
+	}
+
+	// Set headers
+	if payload != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	return c.do(ctx, httpReq, out)
+}
+
+// do sends an authenticated request to JIRA, charging it to the call budget and
+// rate limit, and decodes a successful JSON response into out (if non-nil).
+func (c *Client) do(ctx context.Context, httpReq *http.Request, out interface{}) error {
+	requestURL := httpReq.URL.String()
+	httpReq.Header.Set("Accept", "application/json")
+	c.setAuth(httpReq)
+
+	// Send request
+	resp, err := c.send(ctx, httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to send request to JIRA API: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Check response status
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 { // Check for non-2xx status
+		return newJiraAPIError(resp, requestURL)
+	}
+
+	if err := checkNotLoginPage(resp, requestURL); err != nil {
+		return err
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %v", err)
+	}
+	return nil
+}
+
 // fieldsCommaSeparated joins field names with commas for the query parameter
 func fieldsCommaSeparated(fields []string) string {
 	var sb strings.Builder
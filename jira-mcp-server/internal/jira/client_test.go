@@ -24,16 +24,246 @@ func setupTestServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server,
 	// Create a client configured to talk to the test server
 	// Note: We pass server.Client() to ensure the client uses the test server's transport.
 	// We also need to provide dummy credentials, though they won't be validated by the mock server.
-	t.Setenv("JIRA_URL", server.URL)
-	t.Setenv("JIRA_USER_EMAIL", "test@example.com")
-	t.Setenv("JIRA_API_TOKEN", "test-token")
-
-	client, err := jira.NewClient(server.Client())
+	client, err := jira.NewClient(jira.Config{
+		BaseURL:   server.URL,
+		UserEmail: "test@example.com",
+		APIToken:  "test-token",
+	}, server.Client())
 	require.NoError(t, err, "Failed to create test JIRA client")
 
 	return server, client
 }
 
+// withIssueTypes answers the issue type lookup CreateIssue makes for an issue type
+// name with Task (10001), Story (10002), Subtask (10003) and Bug (10004), and passes
+// every other request on to handler.
+func withIssueTypes(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/rest/api/3/issue/createmeta/") && strings.HasSuffix(r.URL.Path, "/issuetypes") {
+			_, _ = w.Write([]byte(`{"startAt":0,"total":4,"issueTypes":[
+				{"id":"10001","name":"Task"},{"id":"10002","name":"Story"},
+				{"id":"10003","name":"Subtask","subtask":true},{"id":"10004","name":"Bug"}]}`))
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// dummyConfig is used by tests that fail client-side validation before any request is sent.
+var dummyConfig = jira.Config{
+	BaseURL:   "http://dummy.com",
+	UserEmail: "test@example.com",
+	APIToken:  "test-token",
+}
+
+func TestNewClient(t *testing.T) {
+	t.Run("Explicit Config", func(t *testing.T) {
+		var gotUser, gotToken string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotUser, gotToken, _ = r.BasicAuth()
+			_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{}}`))
+		}))
+		defer server.Close()
+
+		client, err := jira.NewClient(jira.Config{
+			BaseURL:   server.URL,
+			UserEmail: "explicit@example.com",
+			APIToken:  "explicit-token",
+		}, server.Client())
+		require.NoError(t, err)
+
+		_, err = client.GetIssue(context.Background(), "TEST-1", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "explicit@example.com", gotUser)
+		assert.Equal(t, "explicit-token", gotToken)
+	})
+
+	t.Run("Error Missing Values", func(t *testing.T) {
+		client, err := jira.NewClient(jira.Config{BaseURL: "http://dummy.com"}, nil)
+		require.Error(t, err)
+		assert.Nil(t, client)
+		assert.Contains(t, err.Error(), "missing required JIRA credentials")
+	})
+
+	t.Run("Authorization Header Per Auth Mode", func(t *testing.T) {
+		testCases := []struct {
+			name       string
+			cfg        jira.Config
+			wantHeader string
+		}{
+			{"Default Is Basic", jira.Config{UserEmail: "bot@example.com", APIToken: "api-token"}, "Basic Ym90QGV4YW1wbGUuY29tOmFwaS10b2tlbg=="},
+			{"Basic", jira.Config{AuthMode: jira.AuthBasic, UserEmail: "bot@example.com", APIToken: "api-token"}, "Basic Ym90QGV4YW1wbGUuY29tOmFwaS10b2tlbg=="},
+			{"Bearer Without Email", jira.Config{AuthMode: jira.AuthBearer, APIToken: "personal-access-token"}, "Bearer personal-access-token"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				var gotHeader string
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					gotHeader = r.Header.Get("Authorization")
+					_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{}}`))
+				}))
+				defer server.Close()
+
+				cfg := tc.cfg
+				cfg.BaseURL = server.URL
+				client, err := jira.NewClient(cfg, server.Client())
+				require.NoError(t, err)
+
+				_, err = client.GetIssue(context.Background(), "TEST-1", nil)
+				require.NoError(t, err)
+				assert.Equal(t, tc.wantHeader, gotHeader)
+			})
+		}
+	})
+
+	t.Run("Error Basic Requires Email", func(t *testing.T) {
+		_, err := jira.NewClient(jira.Config{BaseURL: "http://dummy.com", AuthMode: jira.AuthBasic, APIToken: "token"}, nil)
+		assert.ErrorContains(t, err, "JIRA_USER_EMAIL")
+	})
+
+	t.Run("Error Unknown Auth Mode", func(t *testing.T) {
+		_, err := jira.NewClient(jira.Config{BaseURL: "http://dummy.com", AuthMode: "oauth", APIToken: "token"}, nil)
+		assert.ErrorContains(t, err, `invalid auth mode "oauth"`)
+	})
+
+	t.Run("Error Unknown API Version", func(t *testing.T) {
+		cfg := dummyConfig
+		cfg.APIVersion = 1
+		_, err := jira.NewClient(cfg, nil)
+		assert.ErrorContains(t, err, "invalid API version 1")
+	})
+
+	t.Run("Trailing Slash Normalized", func(t *testing.T) {
+		var gotPath string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{}}`))
+		}))
+		defer server.Close()
+
+		cfg := dummyConfig
+		cfg.BaseURL = server.URL + "/"
+		client, err := jira.NewClient(cfg, server.Client())
+		require.NoError(t, err)
+
+		_, err = client.GetIssue(context.Background(), "TEST-1", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "/rest/api/3/issue/TEST-1", gotPath, "Base URL should not produce a doubled slash")
+	})
+
+	t.Run("Error Invalid Base URL", func(t *testing.T) {
+		testCases := map[string]string{
+			"missing scheme":   "your-domain.atlassian.net",
+			"unsupported":      "ftp://your-domain.atlassian.net",
+			"path not allowed": "https://your-domain.atlassian.net/jira/software",
+		}
+		for name, baseURL := range testCases {
+			t.Run(name, func(t *testing.T) {
+				cfg := dummyConfig
+				cfg.BaseURL = baseURL
+				client, err := jira.NewClient(cfg, nil)
+				require.Error(t, err)
+				assert.Nil(t, client)
+				assert.Contains(t, err.Error(), "invalid JIRA_URL")
+			})
+		}
+	})
+
+	t.Run("Valid HTTPS Base URL", func(t *testing.T) {
+		cfg := dummyConfig
+		cfg.BaseURL = "https://your-domain.atlassian.net"
+		client, err := jira.NewClient(cfg, nil)
+		require.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+
+	t.Run("From Environment", func(t *testing.T) {
+		t.Setenv("JIRA_URL", "http://dummy.com")
+		t.Setenv("JIRA_USER_EMAIL", "test@example.com")
+		t.Setenv("JIRA_API_TOKEN", "test-token")
+
+		client, err := jira.NewClientFromEnv(nil)
+		require.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+}
+
+// TestClient_DataCenter covers a JIRA Data Center instance served under a context
+// path, reached with a Personal Access Token over REST API v2.
+func TestClient_DataCenter(t *testing.T) {
+	ctx := context.Background()
+	var gotPaths, operations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPaths = append(gotPaths, r.Method+" "+r.URL.Path)
+		assert.Equal(t, "Bearer personal-access-token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/jira/rest/api/2/issue/createmeta/TEST/issuetypes":
+			_, _ = w.Write([]byte(`{"startAt":0,"total":1,"issueTypes":[{"id":"10004","name":"Bug"}]}`))
+		case "/jira/rest/api/2/issue":
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{
+				"fields": {
+					"project": { "key": "TEST" },
+					"summary": "Crash on login",
+					"issuetype": { "id": "10004" },
+					"description": "# Steps\n\n*Open* the app",
+					"environment": "Chrome 120"
+				}
+			}`, string(bodyBytes), "API v2 takes rich text as plain strings")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"10100","key":"TEST-1","self":"https://jira.example.com/jira/rest/api/2/issue/10100"}`))
+		case "/jira/rest/api/2/issue/TEST-1/comment":
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"body": "Looking into it"}`, string(bodyBytes))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"1","body":"Looking into it"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := jira.NewClient(jira.Config{
+		BaseURL:      server.URL + "/jira/",
+		AuthMode:     jira.AuthBearer,
+		APIToken:     "personal-access-token",
+		APIVersion:   2,
+		CallObserver: func(operation, _ string) { operations = append(operations, operation) },
+	}, server.Client())
+	require.NoError(t, err)
+
+	created, err := client.CreateIssue(ctx, jira.CreateIssueRequest{
+		ProjectKey:        "TEST",
+		Summary:           "Crash on login",
+		IssueType:         "Bug",
+		Description:       "# Steps\n\n*Open* the app",
+		DescriptionFormat: jira.TextFormatMarkdown,
+		Environment:       "Chrome 120",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://jira.example.com/jira/browse/TEST-1", created.BrowseURL(), "the browse URL should keep the context path")
+
+	_, err = client.AddComment(ctx, "TEST-1", jira.AddCommentRequest{Body: "Looking into it"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"GET /jira/rest/api/2/issue/createmeta/TEST/issuetypes",
+		"POST /jira/rest/api/2/issue",
+		"POST /jira/rest/api/2/issue/TEST-1/comment",
+	}, gotPaths)
+	assert.Equal(t, []string{"other", "create", "other"}, operations, "calls should be classified as on API v3")
+
+	t.Run("Context Path Requires Bearer", func(t *testing.T) {
+		cfg := dummyConfig
+		cfg.BaseURL = server.URL + "/jira"
+		_, err := jira.NewClient(cfg, nil)
+		assert.ErrorContains(t, err, "must not contain a path")
+	})
+}
+
 func TestClient_CreateIssue(t *testing.T) {
 	ctx := context.Background()
 
@@ -42,7 +272,7 @@ func TestClient_CreateIssue(t *testing.T) {
 			"fields": {
 				"project": { "key": "TEST" },
 				"summary": "Test Summary",
-				"issuetype": { "name": "Task" },
+				"issuetype": { "id": "10001" },
 				"description": {
 					"type": "doc",
 					"version": 1,
@@ -60,7 +290,7 @@ func TestClient_CreateIssue(t *testing.T) {
 				}
 			}
 		}`
-		mockResponse := jira.CreateIssueResponse{Key: "TEST-123", Self: "http://fakejira.com/rest/api/3/issue/TEST-123"}
+		mockResponse := jira.CreateIssueResponse{ID: "10123", Key: "TEST-123", Self: "http://fakejira.com/rest/api/3/issue/10123"}
 		mockRespBody, _ := json.Marshal(mockResponse)
 
 		handler := func(w http.ResponseWriter, r *http.Request) {
@@ -80,7 +310,7 @@ func TestClient_CreateIssue(t *testing.T) {
 			_, _ = w.Write(mockRespBody)
 		}
 
-		server, client := setupTestServer(t, handler)
+		server, client := setupTestServer(t, withIssueTypes(handler))
 		defer server.Close()
 
 		req := jira.CreateIssueRequest{
@@ -94,10 +324,379 @@ func TestClient_CreateIssue(t *testing.T) {
 
 		require.NoError(t, err, "CreateIssue should not return an error on success")
 		require.NotNil(t, resp, "Response should not be nil on success")
+		assert.Equal(t, "10123", resp.ID, "Numeric issue ID should be decoded")
 		assert.Equal(t, mockResponse.Key, resp.Key)
 		assert.Equal(t, mockResponse.Self, resp.Self)
 	})
 
+	t.Run("Issue Type By ID", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			var payload struct {
+				Fields map[string]interface{} `json:"fields"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			assert.Equal(t, map[string]interface{}{"id": "10004"}, payload.Fields["issuetype"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"TEST-124","self":"http://fakejira.com/rest/api/3/issue/TEST-124"}`))
+		}
+
+		server, client := setupTestServer(t, withIssueTypes(handler))
+		defer server.Close()
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{ProjectKey: "TEST", Summary: "By ID", IssueType: "10004"})
+		require.NoError(t, err)
+	})
+
+	t.Run("Localized Issue Type Name", func(t *testing.T) {
+		var calls []string
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			if r.Method == http.MethodGet {
+				// Names as returned for a user whose JIRA language is German
+				_, _ = w.Write([]byte(`{"startAt":0,"total":2,"issueTypes":[{"id":"10001","name":"Aufgabe"},{"id":"10004","name":"Fehler"}]}`))
+				return
+			}
+			var payload struct {
+				Fields map[string]interface{} `json:"fields"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			assert.Equal(t, map[string]interface{}{"id": "10004"}, payload.Fields["issuetype"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"10130","key":"TEST-130"}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		for i := 0; i < 2; i++ {
+			_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{ProjectKey: "TEST", Summary: "Absturz", IssueType: "fehler"})
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []string{
+			"GET /rest/api/3/issue/createmeta/TEST/issuetypes",
+			"POST /rest/api/3/issue",
+			"POST /rest/api/3/issue",
+		}, calls, "The project's issue types should be looked up once")
+	})
+
+	t.Run("Unknown Issue Type Name", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodGet, r.Method, "The issue must not be created")
+			_, _ = w.Write([]byte(`{"startAt":0,"total":1,"issueTypes":[{"id":"10001","name":"Task"}]}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{ProjectKey: "TEST", Summary: "S", IssueType: "Epic"})
+		var notFound *jira.IssueTypeNotFoundError
+		require.ErrorAs(t, err, &notFound)
+		assert.Equal(t, "Epic", notFound.IssueType)
+	})
+
+	t.Run("Issue Types Unavailable Falls Back To Name", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			var payload struct {
+				Fields map[string]interface{} `json:"fields"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			assert.Equal(t, map[string]interface{}{"name": "Task"}, payload.Fields["issuetype"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"10131","key":"TEST-131"}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{ProjectKey: "TEST", Summary: "S", IssueType: "Task"})
+		require.NoError(t, err)
+	})
+
+	t.Run("Entity Properties", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{
+				"fields": {
+					"project": { "key": "TEST" },
+					"summary": "With properties",
+					"issuetype": { "id": "10001" }
+				},
+				"properties": [
+					{ "key": "com.example.app", "value": { "synced": true, "externalId": "abc-1" } }
+				]
+			}`, string(bodyBytes))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"TEST-125","self":"http://fakejira.com/rest/api/3/issue/TEST-125"}`))
+		}
+
+		server, client := setupTestServer(t, withIssueTypes(handler))
+		defer server.Close()
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{
+			ProjectKey: "TEST",
+			Summary:    "With properties",
+			IssueType:  "Task",
+			Properties: []jira.EntityProperty{
+				{Key: "com.example.app", Value: map[string]interface{}{"synced": true, "externalId": "abc-1"}},
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Environment As ADF", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{
+				"fields": {
+					"project": { "key": "TEST" },
+					"summary": "Crash on login",
+					"issuetype": { "id": "10004" },
+					"environment": {
+						"type": "doc",
+						"version": 1,
+						"content": [
+							{ "type": "paragraph", "content": [ { "type": "text", "text": "Chrome 120" } ] },
+							{ "type": "paragraph", "content": [ { "type": "text", "text": "macOS 14" } ] }
+						]
+					}
+				}
+			}`, string(bodyBytes))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"TEST-126","self":"http://fakejira.com/rest/api/3/issue/TEST-126"}`))
+		}
+
+		server, client := setupTestServer(t, withIssueTypes(handler))
+		defer server.Close()
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{
+			ProjectKey:  "TEST",
+			Summary:     "Crash on login",
+			IssueType:   "Bug",
+			Environment: "Chrome 120\n\nmacOS 14",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Custom Fields Merged", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{
+				"fields": {
+					"project": { "key": "TEST" },
+					"summary": "Estimate me",
+					"issuetype": { "id": "10002" },
+					"labels": ["backend", "agent"],
+					"customfield_10020": 5
+				}
+			}`, string(bodyBytes), "Custom fields must not override summary")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"TEST-127","self":"http://fakejira.com/rest/api/3/issue/TEST-127"}`))
+		}
+
+		server, client := setupTestServer(t, withIssueTypes(handler))
+		defer server.Close()
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{
+			ProjectKey: "TEST",
+			Summary:    "Estimate me",
+			IssueType:  "Story",
+			CustomFields: map[string]interface{}{
+				"labels":            []string{"backend", "agent"},
+				"customfield_10020": 5,
+				"summary":           "Overridden",
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Multi-Line Text Custom Fields As ADF", func(t *testing.T) {
+		var fieldLookups int
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/rest/api/3/field" {
+				fieldLookups++
+				_, _ = w.Write([]byte(`[
+					{"id":"customfield_10050","name":"Steps to Reproduce","custom":true,"schema":{"custom":"com.atlassian.jira.plugin.system.customfieldtypes:textarea"}},
+					{"id":"customfield_10051","name":"Build","custom":true,"schema":{"custom":"com.atlassian.jira.plugin.system.customfieldtypes:textfield"}}]`))
+				return
+			}
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{
+				"fields": {
+					"project": { "key": "TEST" },
+					"summary": "Crash on login",
+					"issuetype": { "id": "10004" },
+					"customfield_10050": {
+						"type": "doc",
+						"version": 1,
+						"content": [ { "type": "paragraph", "content": [ { "type": "text", "text": "Open the app" } ] } ]
+					},
+					"customfield_10051": "1.2.3"
+				}
+			}`, string(bodyBytes), "only the textarea field should be converted")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"TEST-127","self":"http://fakejira.com/rest/api/3/issue/TEST-127"}`))
+		}
+
+		server, client := setupTestServer(t, withIssueTypes(handler))
+		defer server.Close()
+
+		for i := 0; i < 2; i++ {
+			_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{
+				ProjectKey: "TEST",
+				Summary:    "Crash on login",
+				IssueType:  "Bug",
+				CustomFields: map[string]interface{}{
+					"customfield_10050": "Open the app",
+					"customfield_10051": "1.2.3",
+				},
+			})
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 1, fieldLookups, "field definitions should be cached")
+	})
+
+	t.Run("Epic As Parent", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{
+				"fields": {
+					"project": { "key": "TEST" },
+					"summary": "Story under epic",
+					"issuetype": { "id": "10002" },
+					"parent": { "key": "TEST-100" }
+				}
+			}`, string(bodyBytes))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"TEST-128","self":"http://fakejira.com/rest/api/3/issue/TEST-128"}`))
+		}
+
+		server, client := setupTestServer(t, withIssueTypes(handler))
+		defer server.Close()
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{
+			ProjectKey: "TEST",
+			Summary:    "Story under epic",
+			IssueType:  "Story",
+			EpicKey:    "TEST-100",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Epic Via Epic Link Field", func(t *testing.T) {
+		server := httptest.NewServer(withIssueTypes(func(w http.ResponseWriter, r *http.Request) {
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{
+				"fields": {
+					"project": { "key": "TEST" },
+					"summary": "Story under epic",
+					"issuetype": { "id": "10002" },
+					"customfield_10014": "TEST-100"
+				}
+			}`, string(bodyBytes))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"TEST-129","self":"http://fakejira.com/rest/api/3/issue/TEST-129"}`))
+		}))
+		defer server.Close()
+
+		cfg := dummyConfig
+		cfg.BaseURL = server.URL
+		cfg.EpicLinkFieldID = jira.EpicLinkFieldName
+		cfg.EpicLinkOnCreate = true
+		client, err := jira.NewClient(cfg, server.Client())
+		require.NoError(t, err)
+
+		_, err = client.CreateIssue(ctx, jira.CreateIssueRequest{
+			ProjectKey: "TEST",
+			Summary:    "Story under epic",
+			IssueType:  "Story",
+			EpicKey:    "TEST-100",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Description Line Breaks", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			var payload struct {
+				Fields struct {
+					Description json.RawMessage `json:"description"`
+				} `json:"fields"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			assert.JSONEq(t, `{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[
+					{"type":"text","text":"Steps:"},
+					{"type":"hardBreak"},
+					{"type":"text","text":"1. Open the app"}
+				]},
+				{"type":"paragraph","content":[{"type":"text","text":"It crashes"}]}
+			]}`, string(payload.Fields.Description))
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"TEST-125"}`))
+		}
+
+		server, client := setupTestServer(t, withIssueTypes(handler))
+		defer server.Close()
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{
+			ProjectKey:  "TEST",
+			Summary:     "Multi-line description",
+			IssueType:   "Bug",
+			Description: "Steps:\r\n1. Open the app\n\nIt crashes",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Description Paragraphs Capped", func(t *testing.T) {
+		blocks := make([]string, jira.MaxADFParagraphs+50)
+		for i := range blocks {
+			blocks[i] = fmt.Sprintf("Paragraph %d", i)
+		}
+
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			var payload struct {
+				Fields struct {
+					Description struct {
+						Content []map[string]interface{} `json:"content"`
+					} `json:"description"`
+				} `json:"fields"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			content := payload.Fields.Description.Content
+			assert.Len(t, content, jira.MaxADFParagraphs, "Paragraph nodes should be capped")
+
+			// Overflow is folded into the last paragraph rather than dropped
+			last, _ := json.Marshal(content[len(content)-1])
+			assert.Contains(t, string(last), fmt.Sprintf("Paragraph %d", len(blocks)-1))
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"TEST-124","self":"http://fakejira.com/rest/api/3/issue/TEST-124"}`))
+		}
+
+		server, client := setupTestServer(t, withIssueTypes(handler))
+		defer server.Close()
+
+		req := jira.CreateIssueRequest{
+			ProjectKey:  "TEST",
+			Summary:     "Many paragraphs",
+			IssueType:   "Task",
+			Description: strings.Join(blocks, "\n\n"),
+		}
+
+		_, err := client.CreateIssue(ctx, req)
+		require.NoError(t, err)
+	})
+
 	t.Run("Error 400 Bad Request", func(t *testing.T) {
 		mockErrorResp := `{"errorMessages":["Request validation failed"],"errors":{}}`
 		handler := func(w http.ResponseWriter, r *http.Request) {
@@ -109,7 +708,7 @@ func TestClient_CreateIssue(t *testing.T) {
 			_, _ = w.Write([]byte(mockErrorResp))
 		}
 
-		server, client := setupTestServer(t, handler)
+		server, client := setupTestServer(t, withIssueTypes(handler))
 		defer server.Close()
 
 		// Use a valid request structure but expect the server to reject it
@@ -130,14 +729,44 @@ func TestClient_CreateIssue(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, jiraErr.StatusCode, "Status code should be 400")
 		assert.Contains(t, jiraErr.Message, "Request validation failed", "Error message should contain JIRA error body")
 		assert.Contains(t, jiraErr.Error(), "JIRA API error: status 400", "Formatted error string should contain status")
+		assert.Equal(t, []string{"Request validation failed"}, jiraErr.ErrorMessages)
+		assert.Nil(t, jiraErr.FieldErrors)
+	})
+
+	t.Run("Error 400 Field Errors Parsed", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errorMessages":[],"errors":{"priority":"Field 'priority' is required.","components":"Component name 'X' is not valid"}}`))
+		})
+		defer server.Close()
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{ProjectKey: "TEST", Summary: "S", IssueType: "Task"})
+
+		var jiraErr *jira.JiraAPIError
+		require.ErrorAs(t, err, &jiraErr)
+		assert.Equal(t, map[string]string{"priority": "Field 'priority' is required.", "components": "Component name 'X' is not valid"}, jiraErr.FieldErrors)
+		assert.Equal(t, "components: Component name 'X' is not valid; priority: Field 'priority' is required.", jiraErr.Details())
+	})
+
+	t.Run("Error 400 Without Envelope", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`<html>Bad Request</html>`))
+		})
+		defer server.Close()
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{ProjectKey: "TEST", Summary: "S", IssueType: "Task"})
+
+		var jiraErr *jira.JiraAPIError
+		require.ErrorAs(t, err, &jiraErr)
+		assert.Equal(t, "<html>Bad Request</html>", jiraErr.Message)
+		assert.Empty(t, jiraErr.Details(), "Raw bodies must not be treated as details")
 	})
 
 	t.Run("Error Missing Required Fields Client Side", func(t *testing.T) {
 		// No server needed as validation happens client-side
-		t.Setenv("JIRA_URL", "http://dummy.com")
-		t.Setenv("JIRA_USER_EMAIL", "test@example.com")
-		t.Setenv("JIRA_API_TOKEN", "test-token")
-		client, err := jira.NewClient(nil)
+		client, err := jira.NewClient(dummyConfig, nil)
 		require.NoError(t, err)
 
 		req := jira.CreateIssueRequest{
@@ -196,7 +825,7 @@ func TestClient_SearchIssues(t *testing.T) {
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		resp, err := client.SearchIssues(ctx, expectedJQL, expectedMaxResults, expectedFields)
+		resp, err := client.SearchIssues(ctx, expectedJQL, 0, expectedMaxResults, expectedFields)
 
 		require.NoError(t, err)
 		require.NotNil(t, resp)
@@ -207,6 +836,24 @@ func TestClient_SearchIssues(t *testing.T) {
 		assert.Equal(t, "Found issue", resp.Issues[0].Fields["summary"])
 	})
 
+	t.Run("StartAt Included", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"jql":"project = TEST","startAt":50,"maxResults":50}`, string(bodyBytes))
+			_, _ = w.Write([]byte(`{"startAt":50,"maxResults":50,"total":60,"issues":[{"key":"TEST-51"}]}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		resp, err := client.SearchIssues(ctx, "project = TEST", 50, 50, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 50, resp.StartAt)
+		require.Len(t, resp.Issues, 1)
+		assert.Equal(t, "TEST-51", resp.Issues[0].Key)
+	})
+
 	t.Run("Error 401 Unauthorized", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
 			assert.Equal(t, "POST", r.Method)
@@ -218,7 +865,7 @@ func TestClient_SearchIssues(t *testing.T) {
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		resp, err := client.SearchIssues(ctx, "project = TEST", 10, nil)
+		resp, err := client.SearchIssues(ctx, "project = TEST", 0, 10, nil)
 
 		require.Error(t, err)
 		require.Nil(t, resp)
@@ -233,26 +880,71 @@ func TestClient_SearchIssues(t *testing.T) {
 
 	t.Run("Error Empty JQL", func(t *testing.T) {
 		// No server needed
-		t.Setenv("JIRA_URL", "http://dummy.com")
-		t.Setenv("JIRA_USER_EMAIL", "test@example.com")
-		t.Setenv("JIRA_API_TOKEN", "test-token")
-		client, err := jira.NewClient(nil)
+		client, err := jira.NewClient(dummyConfig, nil)
 		require.NoError(t, err)
 
-		resp, err := client.SearchIssues(ctx, "", 10, nil)
+		resp, err := client.SearchIssues(ctx, "", 0, 10, nil)
 		require.Error(t, err)
 		require.Nil(t, resp)
 		assert.Contains(t, err.Error(), "JQL query cannot be empty")
 	})
 }
 
+func TestClient_SearchIssuesWithExpand(t *testing.T) {
+	ctx := context.Background()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/rest/api/3/search", r.URL.Path)
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"jql":"project = TEST","maxResults":10,"expand":["changelog"]}`, string(bodyBytes))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"total":1,"issues":[{"key":"TEST-1","fields":{},"changelog":{"total":1,"histories":[{"id":"100","author":{"accountId":"abc","displayName":"Alice"},"created":"2024-01-02T09:00:00.000+0000","items":[{"field":"status","fromString":"To Do","toString":"Done"}]}]}}]}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	resp, err := client.SearchIssuesWithExpand(ctx, "project = TEST", 0, 10, nil, []string{"changelog"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Issues, 1)
+	require.NotNil(t, resp.Issues[0].Changelog)
+	require.Len(t, resp.Issues[0].Changelog.Histories, 1)
+	entry := resp.Issues[0].Changelog.Histories[0]
+	assert.Equal(t, "Alice", entry.Author.DisplayName)
+	require.Len(t, entry.Items, 1)
+	assert.Equal(t, "Done", entry.Items[0].ToString)
+}
+
+func TestClient_SearchIssuesWithExpand_StartAt(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		bodyBytes, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"jql":"project = TEST","startAt":10,"maxResults":10,"expand":["changelog"]}`, string(bodyBytes))
+		_, _ = w.Write([]byte(`{"startAt":10,"total":11,"issues":[{"key":"TEST-11","fields":{}}]}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	resp, err := client.SearchIssuesWithExpand(context.Background(), "project = TEST", 10, 10, nil, []string{"changelog"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 10, resp.StartAt)
+	require.Len(t, resp.Issues, 1)
+}
+
 func TestClient_GetIssue(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("Success", func(t *testing.T) {
 		issueKey := "TEST-456"
 		expectedFields := []string{"summary", "status", "assignee"}
-		expectedURL := fmt.Sprintf("/rest/api/3/issue/%s?fields=summary,status,assignee", issueKey)
+		expectedURL := fmt.Sprintf("/rest/api/3/issue/%s?fields=summary%%2Cstatus%%2Cassignee", issueKey)
 
 		mockResponse := jira.Issue{
 			Key: issueKey,
@@ -291,6 +983,22 @@ func TestClient_GetIssue(t *testing.T) {
 		assert.Equal(t, "In Progress", statusMap["name"])
 	})
 
+	t.Run("Escapes Key And Fields", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/rest/api/3/issue/TEST%2F1%3Fx", r.URL.EscapedPath())
+			assert.Equal(t, "fields=summary%2Ccustomfield_10010%2Cname+with+space%26more%3Dyes", r.URL.RawQuery)
+			assert.Equal(t, "summary,customfield_10010,name with space&more=yes", r.URL.Query().Get("fields"))
+
+			_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{}}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		_, err := client.GetIssue(ctx, "TEST/1?x", []string{"summary", "customfield_10010", "name with space&more=yes"})
+		require.NoError(t, err)
+	})
+
 	t.Run("Success No Fields", func(t *testing.T) {
 		issueKey := "TEST-789"
 		expectedURL := fmt.Sprintf("/rest/api/3/issue/%s", issueKey) // No fields param
@@ -340,12 +1048,45 @@ func TestClient_GetIssue(t *testing.T) {
 		assert.Contains(t, jiraErr.Error(), "JIRA API error: status 404", "Formatted error string should contain status")
 	})
 
+	t.Run("Large Numbers Preserved", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{"customfield_10050":12345678901234567890,"customfield_10051":0.1000000000000000055511}}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		issue, err := client.GetIssue(ctx, "TEST-1", nil)
+		require.NoError(t, err)
+
+		encoded, err := json.Marshal(issue.Fields)
+		require.NoError(t, err)
+		assert.Contains(t, string(encoded), `"customfield_10050":12345678901234567890`)
+		assert.Contains(t, string(encoded), `"customfield_10051":0.1000000000000000055511`)
+	})
+
+	t.Run("Error HTML Login Page", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html;charset=UTF-8")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`<!DOCTYPE html><html><head><title>Log in with Atlassian account</title></head><body></body></html>`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		issue, err := client.GetIssue(ctx, "TEST-1", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, issue)
+		var loginPage *jira.LoginPageError
+		require.ErrorAs(t, err, &loginPage)
+		assert.Equal(t, "text/html;charset=UTF-8", loginPage.ContentType)
+	})
+
 	t.Run("Error Empty Issue Key", func(t *testing.T) {
 		// No server needed
-		t.Setenv("JIRA_URL", "http://dummy.com")
-		t.Setenv("JIRA_USER_EMAIL", "test@example.com")
-		t.Setenv("JIRA_API_TOKEN", "test-token")
-		client, err := jira.NewClient(nil)
+		client, err := jira.NewClient(dummyConfig, nil)
 		require.NoError(t, err)
 
 		resp, err := client.GetIssue(ctx, "", nil)
@@ -356,3 +1097,53 @@ func TestClient_GetIssue(t *testing.T) {
 }
 
 // Note: GetEpicIssues is not implemented in client.go, so no tests for it yet.
+
+func TestClient_SearchAllIssues(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Pages Through All Results", func(t *testing.T) {
+		var startAts []float64
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			var payload map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			startAt, _ := payload["startAt"].(float64)
+			startAts = append(startAts, startAt)
+
+			if startAt == 0 {
+				_, _ = w.Write([]byte(`{"startAt":0,"maxResults":2,"total":3,"issues":[{"key":"TEST-1"},{"key":"TEST-2"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"startAt":2,"maxResults":2,"total":3,"issues":[{"key":"TEST-3"}]}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		resp, err := client.SearchAllIssues(ctx, "project = TEST", nil, 10)
+
+		require.NoError(t, err)
+		require.Len(t, resp.Issues, 3)
+		assert.Equal(t, "TEST-3", resp.Issues[2].Key)
+		assert.Equal(t, []float64{0, 2}, startAts)
+	})
+
+	t.Run("Error Result Set Too Large", func(t *testing.T) {
+		requests := 0
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":100,"total":5000000,"issues":[{"key":"TEST-1"}]}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		resp, err := client.SearchAllIssues(ctx, "project = TEST", nil, 1000)
+
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		var tooLarge *jira.ResultSetTooLargeError
+		require.ErrorAs(t, err, &tooLarge)
+		assert.Equal(t, 5000000, tooLarge.Total)
+		assert.Equal(t, 1, requests, "Client must not page once the total exceeds the limit")
+	})
+}
@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CommentVisibility restricts a comment to members of a project role or group.
+type CommentVisibility struct {
+	Type  string `json:"type"`  // "role" or "group"
+	Value string `json:"value"` // Role or group name, e.g. "Administrators"
+}
+
+// AddCommentRequest defines the structure for adding a comment to an issue.
+type AddCommentRequest struct {
+	Body       string             `json:"body"`
+	Visibility *CommentVisibility `json:"visibility,omitempty"`
+
+	// BodyFormat is the format of Body: TextFormatPlain (the default) or TextFormatMarkdown.
+	BodyFormat string `json:"body_format,omitempty"`
+}
+
+// Comment represents a comment on a JIRA issue. Body holds the raw ADF document.
+type Comment struct {
+	ID         string             `json:"id"`
+	Self       string             `json:"self"`
+	Author     User               `json:"author"`
+	Body       interface{}        `json:"body"`
+	Created    string             `json:"created"`
+	Updated    string             `json:"updated"`
+	Visibility *CommentVisibility `json:"visibility,omitempty"`
+}
+
+// AddComment adds a comment to an issue using POST /rest/api/3/issue/{issueKey}/comment.
+// The body is converted to ADF according to req.BodyFormat. If req.Visibility is set, the comment is
+// restricted to the given role or group; otherwise it is visible to everyone who can
+// see the issue.
+func (c *Client) AddComment(ctx context.Context, issueKey string, req AddCommentRequest) (*Comment, error) {
+	if issueKey == "" {
+		return nil, fmt.Errorf("issue key cannot be empty")
+	}
+	defer c.issueCache.invalidate(issueKey)
+
+	if req.Body == "" {
+		return nil, fmt.Errorf("comment body cannot be empty")
+	}
+	if !IsTextFormat(req.BodyFormat) {
+		return nil, fmt.Errorf("unsupported comment body format %q", req.BodyFormat)
+	}
+
+	payload := map[string]interface{}{
+		"body": c.richText(req.Body, req.BodyFormat),
+	}
+	if req.Visibility != nil {
+		if req.Visibility.Type != "role" && req.Visibility.Type != "group" {
+			return nil, fmt.Errorf("comment visibility type must be \"role\" or \"group\"")
+		}
+		if req.Visibility.Value == "" {
+			return nil, fmt.Errorf("comment visibility value cannot be empty")
+		}
+		payload["visibility"] = req.Visibility
+	}
+
+	var comment Comment
+	path := fmt.Sprintf("/rest/api/3/issue/%s/comment", url.PathEscape(issueKey))
+	if err := c.doJSON(ctx, "POST", path, payload, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
@@ -0,0 +1,82 @@
+package jira_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_AddComment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success With Visibility", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "/rest/api/3/issue/PROJ-1/comment", r.URL.Path)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{
+				"body": {"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Internal note"}]}]},
+				"visibility": {"type":"role","value":"Administrators"}
+			}`, string(bodyBytes))
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"10000","self":"http://fakejira.com/rest/api/3/issue/10010/comment/10000","visibility":{"type":"role","value":"Administrators"}}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		comment, err := client.AddComment(ctx, "PROJ-1", jira.AddCommentRequest{
+			Body:       "Internal note",
+			Visibility: &jira.CommentVisibility{Type: "role", Value: "Administrators"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "10000", comment.ID)
+		require.NotNil(t, comment.Visibility)
+		assert.Equal(t, "Administrators", comment.Visibility.Value)
+	})
+
+	t.Run("Success Without Visibility", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			bodyBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.NotContains(t, string(bodyBytes), "visibility", "Visibility should be omitted when not provided")
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"10001"}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		comment, err := client.AddComment(ctx, "PROJ-1", jira.AddCommentRequest{Body: "Public note"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "10001", comment.ID)
+		assert.Nil(t, comment.Visibility)
+	})
+
+	t.Run("Error Invalid Visibility Type", func(t *testing.T) {
+		client, err := jira.NewClient(dummyConfig, nil)
+		require.NoError(t, err)
+
+		comment, err := client.AddComment(ctx, "PROJ-1", jira.AddCommentRequest{
+			Body:       "Note",
+			Visibility: &jira.CommentVisibility{Type: "user", Value: "bob"},
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, comment)
+		assert.Contains(t, err.Error(), "visibility type")
+	})
+}
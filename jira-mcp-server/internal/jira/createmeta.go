@@ -0,0 +1,212 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// createMetaCacheTTL is how long GetCreateMeta results are reused before being
+// fetched again. Field configurations change rarely, so a few minutes is plenty.
+const createMetaCacheTTL = 10 * time.Minute
+
+// createMetaPageSize is the page size used when paging through createmeta endpoints.
+const createMetaPageSize = 50
+
+// IssueType represents an issue type that can be created in a project.
+type IssueType struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Subtask     bool   `json:"subtask"`
+}
+
+// CreateMeta describes the fields available when creating an issue of a given type in a project.
+type CreateMeta struct {
+	ProjectKey string            `json:"projectKey"`
+	IssueType  IssueType         `json:"issueType"`
+	Fields     []CreateMetaField `json:"fields"`
+}
+
+// CreateMetaField describes a single field on the create screen.
+type CreateMetaField struct {
+	FieldID         string         `json:"fieldId"`
+	Key             string         `json:"key"`
+	Name            string         `json:"name"`
+	Required        bool           `json:"required"`
+	HasDefaultValue bool           `json:"hasDefaultValue"`
+	AllowedValues   []AllowedValue `json:"allowedValues,omitempty"`
+}
+
+// AllowedValue is one permitted value of a select-type field. Depending on the
+// field, JIRA identifies options by Name (e.g. priorities) or Value (e.g. custom selects).
+type AllowedValue struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// IssueTypeNotFoundError is returned when the requested issue type is not available
+// for creation in a project. Available lists the issue types that are.
+type IssueTypeNotFoundError struct {
+	ProjectKey string
+	IssueType  string
+	Available  []IssueType
+}
+
+func (e *IssueTypeNotFoundError) Error() string {
+	names := make([]string, 0, len(e.Available))
+	for _, it := range e.Available {
+		names = append(names, it.Name)
+	}
+	return fmt.Sprintf("issue type %q is not available in project %s (available: %s)", e.IssueType, e.ProjectKey, strings.Join(names, ", "))
+}
+
+// issueTypesCacheEntry is a cached list of a project's issue types, used by ResolveIssueTypeID.
+type issueTypesCacheEntry struct {
+	issueTypes []IssueType
+	expiresAt  time.Time
+}
+
+// createMetaCacheEntry is a cached GetCreateMeta result.
+type createMetaCacheEntry struct {
+	meta      *CreateMeta
+	expiresAt time.Time
+}
+
+// GetIssueTypesForProject returns the issue types that can be created in the given project,
+// using GET /rest/api/3/issue/createmeta/{projectKey}/issuetypes.
+func (c *Client) GetIssueTypesForProject(ctx context.Context, projectKey string) ([]IssueType, error) {
+	if projectKey == "" {
+		return nil, fmt.Errorf("project key cannot be empty")
+	}
+
+	var issueTypes []IssueType
+	for startAt := 0; ; {
+		var page struct {
+			StartAt    int         `json:"startAt"`
+			Total      int         `json:"total"`
+			IssueTypes []IssueType `json:"issueTypes"`
+		}
+		path := fmt.Sprintf("/rest/api/3/issue/createmeta/%s/issuetypes?startAt=%d&maxResults=%d", url.PathEscape(projectKey), startAt, createMetaPageSize)
+		if err := c.doJSON(ctx, "GET", path, nil, &page); err != nil {
+			return nil, err
+		}
+		issueTypes = append(issueTypes, page.IssueTypes...)
+		startAt += len(page.IssueTypes)
+		if len(page.IssueTypes) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+	return issueTypes, nil
+}
+
+// ResolveIssueTypeID returns the ID of the named issue type in a project. Issue type
+// names are returned by JIRA in the authenticated user's language, so resolving a
+// localized name (e.g. "Fehler" for "Bug") to its ID lets callers create issues
+// independently of locale. An ID is accepted as well and returned unchanged if valid.
+// If no issue type matches, an *IssueTypeNotFoundError is returned. A project's issue
+// types are cached on the Client for a few minutes, like GetCreateMeta results.
+func (c *Client) ResolveIssueTypeID(ctx context.Context, projectKey, name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("issue type cannot be empty")
+	}
+
+	c.createMetaMu.Lock()
+	entry, ok := c.issueTypesCache[projectKey]
+	c.createMetaMu.Unlock()
+	issueTypes := entry.issueTypes
+	if !ok || !c.clock.Now().Before(entry.expiresAt) {
+		var err error
+		if issueTypes, err = c.GetIssueTypesForProject(ctx, projectKey); err != nil {
+			return "", err
+		}
+		c.createMetaMu.Lock()
+		if c.issueTypesCache == nil {
+			c.issueTypesCache = make(map[string]issueTypesCacheEntry)
+		}
+		c.issueTypesCache[projectKey] = issueTypesCacheEntry{issueTypes: issueTypes, expiresAt: c.clock.Now().Add(createMetaCacheTTL)}
+		c.createMetaMu.Unlock()
+	}
+
+	issueType := findIssueType(issueTypes, name)
+	if issueType == nil {
+		return "", &IssueTypeNotFoundError{ProjectKey: projectKey, IssueType: name, Available: issueTypes}
+	}
+	return issueType.ID, nil
+}
+
+// findIssueType returns the issue type whose ID equals nameOrID or, failing that,
+// whose name matches it case-insensitively. It returns nil if there is no match.
+func findIssueType(issueTypes []IssueType, nameOrID string) *IssueType {
+	for i := range issueTypes {
+		if issueTypes[i].ID == nameOrID {
+			return &issueTypes[i]
+		}
+	}
+	for i := range issueTypes {
+		if strings.EqualFold(issueTypes[i].Name, nameOrID) {
+			return &issueTypes[i]
+		}
+	}
+	return nil
+}
+
+// GetCreateMeta returns the create-screen fields for the named issue type in a project,
+// including whether each field is required and its allowed values. The issue type is
+// matched by ID or case-insensitively by name; if it does not exist an *IssueTypeNotFoundError is returned.
+// Results are cached on the Client for a few minutes.
+func (c *Client) GetCreateMeta(ctx context.Context, projectKey, issueTypeName string) (*CreateMeta, error) {
+	if projectKey == "" || issueTypeName == "" {
+		return nil, fmt.Errorf("project key and issue type cannot be empty")
+	}
+
+	cacheKey := projectKey + "/" + strings.ToLower(issueTypeName)
+	c.createMetaMu.Lock()
+	entry, ok := c.createMetaCache[cacheKey]
+	c.createMetaMu.Unlock()
+	if ok && c.clock.Now().Before(entry.expiresAt) {
+		return entry.meta, nil
+	}
+
+	issueTypes, err := c.GetIssueTypesForProject(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	issueType := findIssueType(issueTypes, issueTypeName)
+	if issueType == nil {
+		return nil, &IssueTypeNotFoundError{ProjectKey: projectKey, IssueType: issueTypeName, Available: issueTypes}
+	}
+
+	// Fields is never nil, so that it is serialized as [] rather than null. Cached
+	// results are shared between callers and must not be modified afterwards.
+	meta := &CreateMeta{ProjectKey: projectKey, IssueType: *issueType, Fields: []CreateMetaField{}}
+	for startAt := 0; ; {
+		var page struct {
+			StartAt int               `json:"startAt"`
+			Total   int               `json:"total"`
+			Fields  []CreateMetaField `json:"fields"`
+		}
+		path := fmt.Sprintf("/rest/api/3/issue/createmeta/%s/issuetypes/%s?startAt=%d&maxResults=%d", url.PathEscape(projectKey), url.PathEscape(issueType.ID), startAt, createMetaPageSize)
+		if err := c.doJSON(ctx, "GET", path, nil, &page); err != nil {
+			return nil, err
+		}
+		meta.Fields = append(meta.Fields, page.Fields...)
+		startAt += len(page.Fields)
+		if len(page.Fields) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+
+	c.createMetaMu.Lock()
+	if c.createMetaCache == nil {
+		c.createMetaCache = make(map[string]createMetaCacheEntry)
+	}
+	c.createMetaCache[cacheKey] = createMetaCacheEntry{meta: meta, expiresAt: c.clock.Now().Add(createMetaCacheTTL)}
+	c.createMetaMu.Unlock()
+
+	return meta, nil
+}
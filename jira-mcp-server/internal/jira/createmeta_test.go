@@ -0,0 +1,149 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/clock"
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_GetCreateMeta(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success And Cached", func(t *testing.T) {
+		requests := 0
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			assert.Equal(t, "GET", r.Method)
+			switch r.URL.Path {
+			case "/rest/api/3/issue/createmeta/PROJ/issuetypes":
+				_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":2,"issueTypes":[{"id":"10001","name":"Task","subtask":false},{"id":"10004","name":"Bug","subtask":false}]}`))
+			case "/rest/api/3/issue/createmeta/PROJ/issuetypes/10004":
+				_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":2,"fields":[
+					{"fieldId":"summary","key":"summary","name":"Summary","required":true,"hasDefaultValue":false},
+					{"fieldId":"priority","key":"priority","name":"Priority","required":true,"hasDefaultValue":false,"allowedValues":[{"id":"1","name":"High"},{"id":"2","name":"Low"}]}
+				]}`))
+			default:
+				t.Errorf("unexpected request path %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		meta, err := client.GetCreateMeta(ctx, "PROJ", "bug") // Case-insensitive match
+
+		require.NoError(t, err)
+		assert.Equal(t, "10004", meta.IssueType.ID)
+		require.Len(t, meta.Fields, 2)
+		assert.True(t, meta.Fields[1].Required)
+		require.Len(t, meta.Fields[1].AllowedValues, 2)
+		assert.Equal(t, "High", meta.Fields[1].AllowedValues[0].Name)
+		assert.Equal(t, 2, requests)
+
+		// Second call is served from the cache
+		_, err = client.GetCreateMeta(ctx, "PROJ", "Bug")
+		require.NoError(t, err)
+		assert.Equal(t, 2, requests, "Cached create metadata should not hit JIRA again")
+	})
+
+	t.Run("Cache Expires With Fake Clock", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if r.URL.Path == "/rest/api/3/issue/createmeta/PROJ/issuetypes" {
+				_, _ = w.Write([]byte(`{"total":1,"issueTypes":[{"id":"10001","name":"Task"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"total":0,"fields":[]}`))
+		}))
+		defer server.Close()
+
+		fakeClock := clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		cfg := dummyConfig
+		cfg.BaseURL = server.URL
+		cfg.Clock = fakeClock
+		client, err := jira.NewClient(cfg, server.Client())
+		require.NoError(t, err)
+
+		meta, err := client.GetCreateMeta(ctx, "PROJ", "Task")
+		require.NoError(t, err)
+		assert.Equal(t, 2, requests)
+		assert.NotNil(t, meta.Fields, "An issue type without fields should have an empty list")
+
+		// Still fresh just before the TTL elapses
+		fakeClock.Advance(9 * time.Minute)
+		_, err = client.GetCreateMeta(ctx, "PROJ", "Task")
+		require.NoError(t, err)
+		assert.Equal(t, 2, requests, "Entry should still be cached")
+
+		// Expired once the TTL has passed
+		fakeClock.Advance(2 * time.Minute)
+		_, err = client.GetCreateMeta(ctx, "PROJ", "Task")
+		require.NoError(t, err)
+		assert.Equal(t, 4, requests, "Expired entry should be fetched again")
+	})
+
+	t.Run("Error Unknown Issue Type", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":1,"issueTypes":[{"id":"10001","name":"Task"}]}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		meta, err := client.GetCreateMeta(ctx, "PROJ", "Epic")
+
+		require.Error(t, err)
+		assert.Nil(t, meta)
+		var notFound *jira.IssueTypeNotFoundError
+		require.ErrorAs(t, err, &notFound)
+		require.Len(t, notFound.Available, 1)
+		assert.Equal(t, "Task", notFound.Available[0].Name)
+	})
+}
+
+func TestClient_ResolveIssueTypeID(t *testing.T) {
+	ctx := context.Background()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/createmeta/PROJ/issuetypes", r.URL.Path)
+		// Names as returned for a user whose JIRA language is German
+		_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":3,"issueTypes":[
+			{"id":"10001","name":"Aufgabe","subtask":false},
+			{"id":"10004","name":"Fehler","subtask":false},
+			{"id":"10007","name":"Unteraufgabe","subtask":true}
+		]}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	t.Run("Localized name", func(t *testing.T) {
+		id, err := client.ResolveIssueTypeID(ctx, "PROJ", "fehler")
+		require.NoError(t, err)
+		assert.Equal(t, "10004", id)
+	})
+
+	t.Run("ID", func(t *testing.T) {
+		id, err := client.ResolveIssueTypeID(ctx, "PROJ", "10007")
+		require.NoError(t, err)
+		assert.Equal(t, "10007", id)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		_, err := client.ResolveIssueTypeID(ctx, "PROJ", "Bug")
+		var notFound *jira.IssueTypeNotFoundError
+		require.ErrorAs(t, err, &notFound)
+		assert.Len(t, notFound.Available, 3)
+		assert.Contains(t, err.Error(), "available: Aufgabe, Fehler, Unteraufgabe")
+	})
+}
@@ -0,0 +1,52 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+)
+
+// maskedValue replaces the values of masked fields in logged bodies.
+const maskedValue = "***"
+
+// logBody logs a JIRA request or response body at debug level, with the values of the
+// client's mask fields replaced by maskedValue wherever they occur in the document.
+// Bodies that are not valid JSON are not logged, as they cannot be masked reliably.
+func (c *Client) logBody(ctx context.Context, msg, requestURL string, body []byte) {
+	if !c.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	var doc interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		c.logger.DebugContext(ctx, msg, "url", requestURL, "body", "(not JSON, omitted)")
+		return
+	}
+	masked, err := json.Marshal(maskFields(doc, c.maskFields))
+	if err != nil {
+		return
+	}
+	c.logger.DebugContext(ctx, msg, "url", requestURL, "body", string(masked))
+}
+
+// maskFields replaces, in place, the value of every object member whose key is in mask.
+func maskFields(v interface{}, mask map[string]bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if mask[key] {
+				v[key] = maskedValue
+			} else {
+				v[key] = maskFields(value, mask)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = maskFields(value, mask)
+		}
+	}
+	return v
+}
@@ -0,0 +1,73 @@
+package jira_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_DebugLogMasksFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"10001","key":"HR-1","self":"http://fakejira.com/rest/api/3/issue/10001"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"key":"HR-1","fields":{"summary":"Offer","customfield_10050":{"value":"SALARY-123456"},
+			"comment":{"comments":[{"customfield_10050":"SALARY-654321"}]}}}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	cfg := dummyConfig
+	cfg.BaseURL = server.URL
+	cfg.Logger = slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	cfg.LogMaskFields = []string{"customfield_10050", "environment"}
+	client, err := jira.NewClient(cfg, server.Client())
+	require.NoError(t, err)
+
+	_, err = client.CreateIssue(context.Background(), jira.CreateIssueRequest{
+		ProjectKey: "HR", Summary: "Offer", IssueType: "10001", Environment: "Candidate SSN 078-05-1120",
+	})
+	require.NoError(t, err)
+
+	issue, err := client.GetIssue(context.Background(), "HR-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"value": "SALARY-123456"}, issue.Fields["customfield_10050"], "Masking must only affect logs")
+
+	logged := logs.String()
+	assert.Contains(t, logged, "Sending JIRA create payload")
+	assert.Contains(t, logged, "Received JIRA issue response")
+	assert.Contains(t, logged, `\"summary\":\"Offer\"`)
+	assert.Contains(t, logged, `\"customfield_10050\":\"***\"`)
+	assert.NotContains(t, logged, "078-05-1120")
+	assert.NotContains(t, logged, "SALARY-123456")
+	assert.NotContains(t, logged, "SALARY-654321")
+}
+
+func TestClient_BodiesNotLoggedAboveDebug(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"key":"HR-1","fields":{"summary":"Offer"}}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	cfg := dummyConfig
+	cfg.BaseURL = server.URL
+	cfg.Logger = slog.New(slog.NewJSONHandler(&logs, nil))
+	client, err := jira.NewClient(cfg, server.Client())
+	require.NoError(t, err)
+
+	_, err = client.GetIssue(context.Background(), "HR-1", nil)
+	require.NoError(t, err)
+	assert.Empty(t, logs.String())
+}
@@ -0,0 +1,43 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// IssueHasSubtasksError is returned by DeleteIssue when JIRA refuses to delete an
+// issue because it still has subtasks and deleteSubtasks was false.
+type IssueHasSubtasksError struct {
+	IssueKey string
+}
+
+func (e *IssueHasSubtasksError) Error() string {
+	return fmt.Sprintf("issue %s has subtasks; set deleteSubtasks to delete them as well", e.IssueKey)
+}
+
+// DeleteIssue deletes an issue using DELETE /rest/api/3/issue/{issueKey}. If
+// deleteSubtasks is true, the issue's subtasks are deleted with it; otherwise
+// JIRA refuses to delete an issue with subtasks and *IssueHasSubtasksError is returned.
+func (c *Client) DeleteIssue(ctx context.Context, issueKey string, deleteSubtasks bool) error {
+	if issueKey == "" {
+		return fmt.Errorf("issue key cannot be empty")
+	}
+	// Deleting an issue also changes its parent's subtasks, and with deleteSubtasks
+	// removes issues that are not named here, so nothing cached can be trusted
+	defer c.issueCache.clear()
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s?deleteSubtasks=%s", url.PathEscape(issueKey), strconv.FormatBool(deleteSubtasks))
+	err := c.doJSON(ctx, "DELETE", path, nil, nil)
+
+	var apiErr *JiraAPIError
+	if !deleteSubtasks && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest &&
+		strings.Contains(strings.ToLower(apiErr.Message), "subtask") {
+		return &IssueHasSubtasksError{IssueKey: issueKey}
+	}
+	return err
+}
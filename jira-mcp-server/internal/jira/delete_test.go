@@ -0,0 +1,58 @@
+package jira_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_DeleteIssue(t *testing.T) {
+	t.Run("Without Subtasks", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "DELETE", r.Method)
+			assert.Equal(t, "/rest/api/3/issue/PROJ-1", r.URL.Path)
+			assert.Equal(t, "false", r.URL.Query().Get("deleteSubtasks"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		require.NoError(t, client.DeleteIssue(context.Background(), "PROJ-1", false))
+	})
+
+	t.Run("Cascade To Subtasks", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "DELETE", r.Method)
+			assert.Equal(t, "true", r.URL.Query().Get("deleteSubtasks"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		require.NoError(t, client.DeleteIssue(context.Background(), "PROJ-1", true))
+	})
+
+	t.Run("Error Has Subtasks", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errorMessages":["The issue has subtasks. To delete the issue, you must delete the subtasks as well."],"errors":{}}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.DeleteIssue(context.Background(), "PROJ-1", false)
+
+		var subtasksErr *jira.IssueHasSubtasksError
+		require.True(t, errors.As(err, &subtasksErr))
+		assert.Equal(t, "PROJ-1", subtasksErr.IssueKey)
+	})
+}
@@ -0,0 +1,102 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// epicLinkSchemaCustom is the schema custom type of the "Epic Link" field.
+const epicLinkSchemaCustom = "com.pyxis.greenhopper.jira:gh-epic-link"
+
+// epicLinkRetryInterval is how long a failed Epic Link lookup is remembered before
+// JIRA is asked again, so that instances without the field are not queried on every create.
+const epicLinkRetryInterval = 10 * time.Minute
+
+// fieldDefinition is an entry of GET /rest/api/3/field.
+type fieldDefinition struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Custom bool   `json:"custom"`
+	Schema struct {
+		Custom string `json:"custom"`
+	} `json:"schema"`
+}
+
+// ResolveEpicLinkField returns the ID of the "Epic Link" custom field. A configured
+// EpicLinkFieldID is returned as is; otherwise the field is looked up with
+// GET /rest/api/3/field by its schema type or name. A found field is cached for the
+// lifetime of the Client, and a failed lookup for epicLinkRetryInterval.
+func (c *Client) ResolveEpicLinkField(ctx context.Context) (string, error) {
+	if c.epicLinkFieldID != "" {
+		return c.epicLinkFieldID, nil
+	}
+
+	c.epicLinkMu.Lock()
+	resolved := c.resolvedEpicLinkField
+	lookupErr, retryAt := c.epicLinkErr, c.epicLinkRetryAt
+	c.epicLinkMu.Unlock()
+	if resolved != "" {
+		return resolved, nil
+	}
+	if lookupErr != nil && c.clock.Now().Before(retryAt) {
+		return "", lookupErr
+	}
+
+	resolved, err := c.lookupEpicLinkField(ctx)
+
+	if err != nil && ctx.Err() != nil {
+		// The caller gave up; that says nothing about the field
+		return "", err
+	}
+
+	c.epicLinkMu.Lock()
+	if err != nil {
+		c.epicLinkErr, c.epicLinkRetryAt = err, c.clock.Now().Add(epicLinkRetryInterval)
+	} else {
+		c.resolvedEpicLinkField, c.epicLinkErr = resolved, nil
+	}
+	c.epicLinkMu.Unlock()
+	return resolved, err
+}
+
+// lookupEpicLinkField finds the Epic Link field in GET /rest/api/3/field.
+func (c *Client) lookupEpicLinkField(ctx context.Context) (string, error) {
+	var fields []fieldDefinition
+	if err := c.doJSON(ctx, "GET", "/rest/api/3/field", nil, &fields); err != nil {
+		return "", err
+	}
+
+	var resolved string
+
+	// The schema type is authoritative; the name may be localized or reused
+	for _, f := range fields {
+		if f.Schema.Custom == epicLinkSchemaCustom {
+			resolved = f.ID
+			break
+		}
+	}
+	if resolved == "" {
+		for _, f := range fields {
+			if f.Custom && f.Name == "Epic Link" {
+				resolved = f.ID
+				break
+			}
+		}
+	}
+	if resolved == "" {
+		return "", fmt.Errorf("no Epic Link field found on this JIRA instance")
+	}
+	return resolved, nil
+}
+
+// epicLinkField returns the Epic Link field to use for ctx, falling back to
+// EpicLinkFieldName if it cannot be resolved.
+func (c *Client) epicLinkField(ctx context.Context) string {
+	field, err := c.ResolveEpicLinkField(ctx)
+	if err != nil {
+		c.logger.WarnContext(ctx, "Failed to resolve the Epic Link field; using the default", "default", EpicLinkFieldName, "error", err)
+		return EpicLinkFieldName
+	}
+	return field
+}
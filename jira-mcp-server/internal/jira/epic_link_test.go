@@ -0,0 +1,109 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/clock"
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_ResolveEpicLinkField(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("By Schema Type And Cached", func(t *testing.T) {
+		calls := 0
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "/rest/api/3/field", r.URL.Path)
+			_, _ = w.Write([]byte(`[
+				{"id": "summary", "name": "Summary", "custom": false, "schema": {"type": "string", "system": "summary"}},
+				{"id": "customfield_10014", "name": "Epic Link (old)", "custom": true, "schema": {"type": "string", "custom": "com.atlassian.jira.plugin.system.customfieldtypes:textfield"}},
+				{"id": "customfield_10008", "name": "Verknüpfter Epic", "custom": true, "schema": {"type": "any", "custom": "com.pyxis.greenhopper.jira:gh-epic-link"}}
+			]`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		field, err := client.ResolveEpicLinkField(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "customfield_10008", field)
+
+		field, err = client.ResolveEpicLinkField(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "customfield_10008", field)
+		assert.Equal(t, 1, calls, "The resolved field should be cached")
+	})
+
+	t.Run("By Name", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[{"id": "customfield_10101", "name": "Epic Link", "custom": true, "schema": {"type": "any"}}]`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		field, err := client.ResolveEpicLinkField(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "customfield_10101", field)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[{"id": "summary", "name": "Summary", "custom": false, "schema": {"type": "string"}}]`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		_, err := client.ResolveEpicLinkField(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no Epic Link field found")
+	})
+
+	t.Run("Failed Lookup Cached Until Retry Interval", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			_, _ = w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+
+		fakeClock := clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		cfg := dummyConfig
+		cfg.BaseURL = server.URL
+		cfg.Clock = fakeClock
+		client, err := jira.NewClient(cfg, server.Client())
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err = client.ResolveEpicLinkField(ctx)
+			require.Error(t, err)
+		}
+		assert.Equal(t, 1, calls, "The failed lookup should be cached")
+
+		fakeClock.Advance(10 * time.Minute)
+		_, err = client.ResolveEpicLinkField(ctx)
+		require.Error(t, err)
+		assert.Equal(t, 2, calls, "The lookup should be retried after the interval")
+	})
+
+	t.Run("Configured Field Skips Lookup", func(t *testing.T) {
+		cfg := dummyConfig
+		cfg.EpicLinkFieldID = "customfield_12345"
+		client, err := jira.NewClient(cfg, nil) // No server: any request would fail
+		require.NoError(t, err)
+
+		field, err := client.ResolveEpicLinkField(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "customfield_12345", field)
+	})
+}
@@ -0,0 +1,34 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IssueExists reports whether an issue exists using GET /rest/api/3/issue/{issueKey}
+// with an empty fields list, so no field data is transferred. A 404 from JIRA
+// yields false; a 403 (the issue exists but cannot be viewed) is returned as a
+// *JiraAPIError like any other failure.
+func (c *Client) IssueExists(ctx context.Context, issueKey string) (bool, error) {
+	if issueKey == "" {
+		return false, fmt.Errorf("issue key cannot be empty")
+	}
+
+	var issue struct {
+		Key string `json:"key"`
+	}
+	path := fmt.Sprintf("/rest/api/3/issue/%s?fields=", url.PathEscape(issueKey))
+	err := c.doJSON(ctx, "GET", path, nil, &issue)
+
+	var apiErr *JiraAPIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,53 @@
+package jira_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_IssueExists(t *testing.T) {
+	testCases := []struct {
+		name       string
+		status     int
+		body       string
+		wantExists bool
+		wantStatus int // JiraAPIError status expected, 0 for no error
+	}{
+		{"Exists", http.StatusOK, `{"id":"10001","key":"PROJ-1","fields":{}}`, true, 0},
+		{"Not Found", http.StatusNotFound, `{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`, false, 0},
+		{"Permission Denied", http.StatusForbidden, `{"errorMessages":["You do not have the permission to see the specified issue."]}`, false, http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/rest/api/3/issue/PROJ-1", r.URL.Path)
+				assert.True(t, r.URL.Query().Has("fields"), "fields must be sent, empty, to skip field data")
+				assert.Empty(t, r.URL.Query().Get("fields"))
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}
+
+			server, client := setupTestServer(t, handler)
+			defer server.Close()
+
+			exists, err := client.IssueExists(context.Background(), "PROJ-1")
+
+			assert.Equal(t, tc.wantExists, exists)
+			if tc.wantStatus == 0 {
+				require.NoError(t, err)
+				return
+			}
+			var apiErr *jira.JiraAPIError
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tc.wantStatus, apiErr.StatusCode)
+		})
+	}
+}
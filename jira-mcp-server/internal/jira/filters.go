@@ -0,0 +1,38 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Filter is a saved JIRA search.
+type Filter struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	JQL  string `json:"jql"`
+}
+
+// GetFilters returns the filters owned by the authenticated user using
+// GET /rest/api/3/filter/my.
+func (c *Client) GetFilters(ctx context.Context) ([]Filter, error) {
+	var filters []Filter
+	if err := c.doJSON(ctx, "GET", "/rest/api/3/filter/my", nil, &filters); err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+// GetFilter returns a single filter, including its JQL, using GET /rest/api/3/filter/{id}.
+// Filters shared with the user can be fetched as well as their own.
+func (c *Client) GetFilter(ctx context.Context, filterID string) (*Filter, error) {
+	if filterID == "" {
+		return nil, fmt.Errorf("filter ID cannot be empty")
+	}
+
+	var filter Filter
+	if err := c.doJSON(ctx, "GET", "/rest/api/3/filter/"+url.PathEscape(filterID), nil, &filter); err != nil {
+		return nil, err
+	}
+	return &filter, nil
+}
@@ -0,0 +1,50 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_GetFilters(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/3/filter/my", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"self":"http://fakejira.com/rest/api/3/filter/10000","id":"10000","name":"My open bugs","jql":"type = Bug AND assignee = currentUser()","favourite":true},
+			{"self":"http://fakejira.com/rest/api/3/filter/10001","id":"10001","name":"Release blockers","jql":"priority = Blocker"}
+		]`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	filters, err := client.GetFilters(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []jira.Filter{
+		{ID: "10000", Name: "My open bugs", JQL: "type = Bug AND assignee = currentUser()"},
+		{ID: "10001", Name: "Release blockers", JQL: "priority = Blocker"},
+	}, filters)
+}
+
+func TestClient_GetFilter(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/3/filter/10001", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"10001","name":"Release blockers","jql":"priority = Blocker"}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	filter, err := client.GetFilter(context.Background(), "10001")
+	require.NoError(t, err)
+	assert.Equal(t, &jira.Filter{ID: "10001", Name: "Release blockers", JQL: "priority = Blocker"}, filter)
+}
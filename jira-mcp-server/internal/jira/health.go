@@ -0,0 +1,12 @@
+package jira
+
+import (
+	"context"
+)
+
+// Ping checks that JIRA is reachable and accepts the configured credentials by
+// fetching the authenticated user (see GetCurrentUser). The user is returned so
+// callers can report who the server is acting as.
+func (c *Client) Ping(ctx context.Context) (*User, error) {
+	return c.GetCurrentUser(ctx)
+}
@@ -0,0 +1,40 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_Ping(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "/rest/api/3/myself", r.URL.Path)
+			_, _ = w.Write([]byte(`{"accountId":"abc-123","displayName":"JIRA Bot","active":true}`))
+		})
+		defer server.Close()
+
+		user, err := client.Ping(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "abc-123", user.AccountID)
+		assert.Equal(t, "JIRA Bot", user.DisplayName)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		defer server.Close()
+
+		_, err := client.Ping(context.Background())
+		var apiErr *jira.JiraAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	})
+}
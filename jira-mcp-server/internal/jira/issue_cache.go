@@ -0,0 +1,130 @@
+package jira
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+
+	"jira-mcp-server/internal/clock"
+)
+
+// DefaultIssueCacheSize is the number of GetIssue responses kept when caching is enabled.
+const DefaultIssueCacheSize = 500
+
+// IssueCacheObserver is notified of every GetIssue lookup while the issue cache is
+// enabled, e.g. to count hits and misses in metrics.
+type IssueCacheObserver func(hit bool)
+
+// issueCache is a size-bounded LRU cache of GetIssue response bodies with a TTL.
+// Bodies rather than decoded issues are kept so that callers modifying a returned
+// Issue cannot change what later lookups see. A nil *issueCache caches nothing.
+type issueCache struct {
+	ttl      time.Duration
+	size     int
+	clock    clock.Clock
+	observer IssueCacheObserver
+
+	mu      sync.Mutex
+	order   *list.List // Most recently used first; values are *issueCacheEntry
+	entries map[string]*list.Element
+}
+
+type issueCacheEntry struct {
+	key       string
+	issueKey  string
+	body      []byte
+	expiresAt time.Time
+}
+
+// newIssueCache returns a cache of up to size entries, or nil if ttl is not positive.
+func newIssueCache(ttl time.Duration, size int, clk clock.Clock, observer IssueCacheObserver) *issueCache {
+	if ttl <= 0 {
+		return nil
+	}
+	if size <= 0 {
+		size = DefaultIssueCacheSize
+	}
+	return &issueCache{ttl: ttl, size: size, clock: clk, observer: observer, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// issueCacheKey identifies a GetIssue call by issue key and requested fields.
+func issueCacheKey(issueKey string, fields []string) string {
+	return issueKey + "?" + strings.Join(fields, ",")
+}
+
+// get returns the cached body for key, if present and not expired.
+func (c *issueCache) get(key string) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	var body []byte
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*issueCacheEntry)
+		if c.clock.Now().Before(entry.expiresAt) {
+			c.order.MoveToFront(el)
+			body = entry.body
+		} else {
+			c.remove(el)
+		}
+	}
+	c.mu.Unlock()
+
+	if c.observer != nil {
+		c.observer(body != nil)
+	}
+	return body, body != nil
+}
+
+// put stores body under key, evicting the least recently used entry if the cache is full.
+func (c *issueCache) put(key, issueKey string, body []byte) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	c.entries[key] = c.order.PushFront(&issueCacheEntry{key: key, issueKey: issueKey, body: body, expiresAt: c.clock.Now().Add(c.ttl)})
+	if c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// invalidate drops every cached response for issueKey, whatever fields were requested.
+func (c *issueCache) invalidate(issueKey string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for el := c.order.Front(); el != nil; {
+		next := el.Next()
+		if el.Value.(*issueCacheEntry).issueKey == issueKey {
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+// clear drops every cached response, for changes that affect issues whose keys are not known.
+func (c *issueCache) clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
+
+// remove deletes el from the cache. c.mu must be held.
+func (c *issueCache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*issueCacheEntry).key)
+}
@@ -0,0 +1,141 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/clock"
+	"jira-mcp-server/internal/jira"
+)
+
+// setupCachingTestServer is setupTestServer with the issue cache enabled. It counts
+// the GetIssue requests that reach the server and the cache hits and misses.
+func setupCachingTestServer(t *testing.T, ttl time.Duration, clk clock.Clock) (client *jira.Client, gets *atomic.Int32, hits, misses *int) {
+	t.Helper()
+	gets = new(atomic.Int32)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			gets.Add(1)
+			_, _ = w.Write([]byte(`{"id":"10001","key":"PROJ-1","fields":{"summary":"Cached"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	hits, misses = new(int), new(int)
+	client, err := jira.NewClient(jira.Config{
+		BaseURL:       server.URL,
+		UserEmail:     "test@example.com",
+		APIToken:      "test-token",
+		Clock:         clk,
+		IssueCacheTTL: ttl,
+		IssueCacheObserver: func(hit bool) {
+			if hit {
+				*hits++
+			} else {
+				*misses++
+			}
+		},
+	}, server.Client())
+	require.NoError(t, err)
+	return client, gets, hits, misses
+}
+
+func TestClient_GetIssue_Cache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Second Lookup Within TTL Is Served From Cache", func(t *testing.T) {
+		client, gets, hits, misses := setupCachingTestServer(t, time.Minute, nil)
+
+		first, err := client.GetIssue(ctx, "PROJ-1", nil)
+		require.NoError(t, err)
+		first.Fields["summary"] = "Changed by the caller"
+
+		second, err := client.GetIssue(ctx, "PROJ-1", nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(1), gets.Load(), "the second lookup should not reach JIRA")
+		assert.Equal(t, "Cached", second.Fields["summary"], "changes to a returned issue must not leak into the cache")
+		assert.Equal(t, 1, *hits)
+		assert.Equal(t, 1, *misses)
+	})
+
+	t.Run("Keyed By Fields", func(t *testing.T) {
+		client, gets, _, _ := setupCachingTestServer(t, time.Minute, nil)
+
+		_, err := client.GetIssue(ctx, "PROJ-1", []string{"summary"})
+		require.NoError(t, err)
+		_, err = client.GetIssue(ctx, "PROJ-1", []string{"summary", "status"})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), gets.Load())
+	})
+
+	t.Run("Expires After TTL", func(t *testing.T) {
+		fakeClock := clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		client, gets, _, _ := setupCachingTestServer(t, time.Minute, fakeClock)
+
+		_, err := client.GetIssue(ctx, "PROJ-1", nil)
+		require.NoError(t, err)
+		fakeClock.Advance(time.Minute)
+		_, err = client.GetIssue(ctx, "PROJ-1", nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), gets.Load())
+	})
+
+	t.Run("Invalidated By Writes", func(t *testing.T) {
+		writes := map[string]func(*jira.Client) error{
+			"UpdateIssue": func(c *jira.Client) error {
+				summary := "New"
+				return c.UpdateIssue(ctx, "PROJ-1", jira.UpdateIssueRequest{Summary: &summary})
+			},
+			"TransitionIssue": func(c *jira.Client) error { return c.TransitionIssue(ctx, "PROJ-1", "31") },
+			"AddComment": func(c *jira.Client) error {
+				_, err := c.AddComment(ctx, "PROJ-1", jira.AddCommentRequest{Body: "Done"})
+				return err
+			},
+			"AssignIssue":   func(c *jira.Client) error { return c.AssignIssue(ctx, "PROJ-1", "abc-1") },
+			"UpdateLabels":  func(c *jira.Client) error { return c.UpdateLabels(ctx, "PROJ-1", []string{"backend"}, nil) },
+			"AddWatcher":    func(c *jira.Client) error { return c.AddWatcher(ctx, "PROJ-1", "abc-1") },
+			"RemoveWatcher": func(c *jira.Client) error { return c.RemoveWatcher(ctx, "PROJ-1", "abc-1") },
+			"RankIssues": func(c *jira.Client) error {
+				return c.RankIssues(ctx, jira.RankIssuesRequest{Issues: []string{"PROJ-1"}, RankBeforeIssue: "PROJ-2"})
+			},
+			"DeleteSubtaskOfAnotherIssue": func(c *jira.Client) error { return c.DeleteIssue(ctx, "PROJ-2", false) },
+		}
+		for name, write := range writes {
+			t.Run(name, func(t *testing.T) {
+				client, gets, _, _ := setupCachingTestServer(t, time.Minute, nil)
+
+				_, err := client.GetIssue(ctx, "PROJ-1", []string{"summary"})
+				require.NoError(t, err)
+				require.NoError(t, write(client))
+				_, err = client.GetIssue(ctx, "PROJ-1", []string{"summary"})
+				require.NoError(t, err)
+
+				assert.Equal(t, int32(2), gets.Load(), "the write should drop the cached issue")
+			})
+		}
+	})
+
+	t.Run("Disabled By Default", func(t *testing.T) {
+		client, gets, hits, misses := setupCachingTestServer(t, 0, nil)
+
+		for i := 0; i < 2; i++ {
+			_, err := client.GetIssue(ctx, "PROJ-1", nil)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(2), gets.Load())
+		assert.Zero(t, *hits+*misses, "lookups are not observed while the cache is disabled")
+	})
+}
@@ -0,0 +1,33 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// UpdateLabels adds and removes labels on an issue using the "update" operations of
+// PUT /rest/api/3/issue/{issueKey}. Unlike setting the labels field, this leaves any
+// other labels untouched, so concurrent edits do not overwrite each other. Adding a
+// label the issue already has, or removing one it lacks, is not an error.
+func (c *Client) UpdateLabels(ctx context.Context, issueKey string, add, remove []string) error {
+	if issueKey == "" {
+		return fmt.Errorf("issue key cannot be empty")
+	}
+	defer c.issueCache.invalidate(issueKey)
+
+	if len(add) == 0 && len(remove) == 0 {
+		return fmt.Errorf("at least one label to add or remove is required")
+	}
+
+	ops := make([]map[string]string, 0, len(add)+len(remove))
+	for _, label := range add {
+		ops = append(ops, map[string]string{"add": label})
+	}
+	for _, label := range remove {
+		ops = append(ops, map[string]string{"remove": label})
+	}
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s", url.PathEscape(issueKey))
+	return c.doJSON(ctx, "PUT", path, map[string]interface{}{"update": map[string]interface{}{"labels": ops}}, nil)
+}
@@ -0,0 +1,41 @@
+package jira_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_UpdateLabels(t *testing.T) {
+	t.Run("Sends Update Operations", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "PUT", r.Method)
+			assert.Equal(t, "/rest/api/3/issue/PROJ-1", r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"update":{"labels":[{"add":"backend"},{"add":"urgent"},{"remove":"triage"}]}}`, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.UpdateLabels(context.Background(), "PROJ-1", []string{"backend", "urgent"}, []string{"triage"})
+		require.NoError(t, err)
+	})
+
+	t.Run("No Labels", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Error("JIRA should not be called")
+		})
+		defer server.Close()
+
+		err := client.UpdateLabels(context.Background(), "PROJ-1", nil, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one label")
+	})
+}
@@ -0,0 +1,51 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// LinkTypeRelates is the name of JIRA's default "relates to" issue link type.
+const LinkTypeRelates = "Relates"
+
+// LinkIssues links two issues with the named link type (e.g. "Blocks" or "Relates")
+// using POST /rest/api/3/issueLink. For directional types, outwardKey is the issue
+// the link points from ("outwardKey blocks inwardKey").
+func (c *Client) LinkIssues(ctx context.Context, inwardKey, outwardKey, linkType string) error {
+	if inwardKey == "" || outwardKey == "" || linkType == "" {
+		return fmt.Errorf("inward key, outward key and link type are required")
+	}
+	defer c.issueCache.invalidate(inwardKey)
+	defer c.issueCache.invalidate(outwardKey)
+
+	payload := map[string]interface{}{
+		"type":         map[string]string{"name": linkType},
+		"inwardIssue":  map[string]string{"key": inwardKey},
+		"outwardIssue": map[string]string{"key": outwardKey},
+	}
+	return c.doJSON(ctx, "POST", "/rest/api/3/issueLink", payload, nil)
+}
+
+// LinkType is an issue link type configured in JIRA, e.g. "Blocks" with the
+// descriptions "is blocked by" (inward) and "blocks" (outward).
+type LinkType struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+}
+
+// GetLinkTypes returns the issue link types configured in JIRA using
+// GET /rest/api/3/issueLinkType. Their names are valid link types for LinkIssues.
+func (c *Client) GetLinkTypes(ctx context.Context) ([]LinkType, error) {
+	var resp struct {
+		IssueLinkTypes []LinkType `json:"issueLinkTypes"`
+	}
+	if err := c.doJSON(ctx, "GET", "/rest/api/3/issueLinkType", nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get issue link types: %w", err)
+	}
+	if resp.IssueLinkTypes == nil {
+		return []LinkType{}, nil
+	}
+	return resp.IssueLinkTypes, nil
+}
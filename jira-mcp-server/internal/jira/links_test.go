@@ -0,0 +1,45 @@
+package jira_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_LinkIssues(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/rest/api/3/issueLink", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":{"name":"Relates"},"inwardIssue":{"key":"PROJ-2"},"outwardIssue":{"key":"PROJ-1"}}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	err := client.LinkIssues(context.Background(), "PROJ-2", "PROJ-1", jira.LinkTypeRelates)
+	require.NoError(t, err)
+}
+
+func TestClient_GetLinkTypes(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/3/issueLinkType", r.URL.Path)
+		_, _ = w.Write([]byte(`{"issueLinkTypes":[{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks","self":"https://jira/rest/api/3/issueLinkType/10000"}]}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	types, err := client.GetLinkTypes(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []jira.LinkType{{ID: "10000", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"}}, types)
+}
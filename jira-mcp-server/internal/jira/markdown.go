@@ -0,0 +1,293 @@
+package jira
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Text formats accepted for rich text fields such as descriptions and comments.
+// Plain text is the default: blank-line separated blocks become paragraphs and
+// everything else is kept literally.
+const (
+	TextFormatPlain    = "plain"
+	TextFormatMarkdown = "markdown"
+)
+
+// IsTextFormat reports whether format is a supported text format. The empty
+// string selects TextFormatPlain.
+func IsTextFormat(format string) bool {
+	return format == "" || format == TextFormatPlain || format == TextFormatMarkdown
+}
+
+// richTextToADF converts text in the given format to an ADF "doc" node.
+func richTextToADF(text, format string) map[string]interface{} {
+	if format == TextFormatMarkdown {
+		return markdownToADF(text)
+	}
+	return textToADF(text)
+}
+
+var (
+	mdHeading     = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	mdFence       = regexp.MustCompile("^\\s*(```|~~~)\\s*([^\\s`]*)")
+	mdBulletItem  = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	mdOrderedItem = regexp.MustCompile(`^(\s*)(\d{1,9})[.)]\s+(.*)$`)
+)
+
+// markdownToADF converts Markdown to an ADF "doc" node. It supports ATX headings,
+// bold and italic, inline code, fenced code blocks and (nested) bullet and ordered
+// lists; lines within a paragraph are kept as hard breaks. Anything else is kept
+// as literal text. Like textToADF, the document is capped at MaxADFParagraphs
+// top-level blocks, with the remaining source folded into a final paragraph.
+func markdownToADF(md string) map[string]interface{} {
+	lines := strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n")
+
+	content := []map[string]interface{}{}
+	for i := 0; i < len(lines); {
+		if strings.TrimSpace(lines[i]) == "" {
+			i++
+			continue
+		}
+		if len(content) == MaxADFParagraphs-1 {
+			rest := strings.Trim(strings.Join(lines[i:], "\n"), "\n")
+			content = append(content, adfParagraph([]map[string]interface{}{adfText(rest, nil)}))
+			break
+		}
+		var block map[string]interface{}
+		block, i = parseMarkdownBlock(lines, i)
+		content = append(content, block)
+	}
+
+	return map[string]interface{}{
+		"type":    "doc",
+		"version": 1,
+		"content": content,
+	}
+}
+
+// parseMarkdownBlock parses the block starting at lines[i], which is not blank,
+// and returns it with the index of the first line after it.
+func parseMarkdownBlock(lines []string, i int) (map[string]interface{}, int) {
+	line := lines[i]
+
+	if m := mdFence.FindStringSubmatch(line); m != nil {
+		var code []string
+		j := i + 1
+		for ; j < len(lines); j++ {
+			if strings.HasPrefix(strings.TrimSpace(lines[j]), m[1]) {
+				break
+			}
+			code = append(code, lines[j])
+		}
+		block := map[string]interface{}{"type": "codeBlock"}
+		if m[2] != "" {
+			block["attrs"] = map[string]interface{}{"language": m[2]}
+		}
+		if text := strings.Join(code, "\n"); text != "" {
+			block["content"] = []map[string]interface{}{adfText(text, nil)}
+		}
+		// An unclosed fence runs to the end of the document
+		return block, j + 1
+	}
+
+	if m := mdHeading.FindStringSubmatch(line); m != nil {
+		return map[string]interface{}{
+			"type":    "heading",
+			"attrs":   map[string]interface{}{"level": len(m[1])},
+			"content": parseMarkdownInline(m[2]),
+		}, i + 1
+	}
+
+	if _, _, _, ok := markdownListItem(line); ok {
+		return parseMarkdownList(lines, i)
+	}
+
+	// A paragraph runs until a blank line or the start of another block
+	var paragraph []string
+	j := i
+	for ; j < len(lines); j++ {
+		l := lines[j]
+		if j > i && (strings.TrimSpace(l) == "" || mdFence.MatchString(l) || mdHeading.MatchString(l)) {
+			break
+		}
+		if _, _, _, ok := markdownListItem(l); j > i && ok {
+			break
+		}
+		paragraph = append(paragraph, strings.TrimSpace(l))
+	}
+	var inline []map[string]interface{}
+	for k, l := range paragraph {
+		if k > 0 {
+			inline = append(inline, map[string]interface{}{"type": "hardBreak"})
+		}
+		inline = append(inline, parseMarkdownInline(l)...)
+	}
+	return adfParagraph(inline), j
+}
+
+// markdownListItem reports whether line is a list item and returns its
+// indentation, list type ("bulletList" or "orderedList") and text.
+func markdownListItem(line string) (indent int, listType string, text string, ok bool) {
+	if m := mdBulletItem.FindStringSubmatch(line); m != nil {
+		return len(m[1]), "bulletList", m[2], true
+	}
+	if m := mdOrderedItem.FindStringSubmatch(line); m != nil {
+		return len(m[1]), "orderedList", m[3], true
+	}
+	return 0, "", "", false
+}
+
+// parseMarkdownList parses the list whose first item is lines[i]. Items indented
+// further than the list's first item start a nested list, and other indented
+// lines continue the previous item. The list ends at a blank line, a less
+// indented item, an item of a different list type or an unindented line.
+func parseMarkdownList(lines []string, i int) (map[string]interface{}, int) {
+	indent, listType, _, _ := markdownListItem(lines[i])
+	list := map[string]interface{}{"type": listType}
+	if listType == "orderedList" {
+		order, _ := strconv.Atoi(mdOrderedItem.FindStringSubmatch(lines[i])[2])
+		list["attrs"] = map[string]interface{}{"order": order}
+	}
+
+	var items []map[string]interface{}
+	for i < len(lines) {
+		line := lines[i]
+		if strings.TrimSpace(line) == "" {
+			break
+		}
+		itemIndent, itemType, text, isItem := markdownListItem(line)
+		switch {
+		case isItem && itemIndent > indent && len(items) > 0:
+			var nested map[string]interface{}
+			nested, i = parseMarkdownList(lines, i)
+			last := items[len(items)-1]
+			last["content"] = append(last["content"].([]map[string]interface{}), nested)
+			continue
+		case isItem && itemIndent == indent && itemType == listType:
+			items = append(items, map[string]interface{}{
+				"type":    "listItem",
+				"content": []map[string]interface{}{adfParagraph(parseMarkdownInline(text))},
+			})
+		case !isItem && len(line)-len(strings.TrimLeft(line, " \t")) > indent && len(items) > 0:
+			// A continuation line of the last item's first paragraph
+			paragraph := items[len(items)-1]["content"].([]map[string]interface{})[0]
+			inline := append(paragraph["content"].([]map[string]interface{}), map[string]interface{}{"type": "hardBreak"})
+			paragraph["content"] = append(inline, parseMarkdownInline(strings.TrimSpace(line))...)
+		default:
+			list["content"] = items
+			return list, i
+		}
+		i++
+	}
+	list["content"] = items
+	return list, i
+}
+
+// parseMarkdownInline converts inline Markdown (**bold**, __bold__, *italic*,
+// _italic_ and `code`) to ADF text nodes with marks. Unmatched delimiters are
+// kept as literal text, as are underscores inside words such as snake_case.
+func parseMarkdownInline(s string) []map[string]interface{} {
+	nodes := parseMarkdownInlineMarks(s, nil)
+	if len(nodes) == 0 {
+		return []map[string]interface{}{}
+	}
+	return nodes
+}
+
+func parseMarkdownInlineMarks(s string, marks []string) []map[string]interface{} {
+	var nodes []map[string]interface{}
+	var plain strings.Builder
+	flush := func() {
+		if plain.Len() > 0 {
+			nodes = append(nodes, adfText(plain.String(), marks))
+			plain.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end > 0 {
+				flush()
+				// ADF does not allow code to be combined with strong or em
+				nodes = append(nodes, adfText(s[i+1:i+1+end], []string{"code"}))
+				i += end + 2
+				continue
+			}
+		case (c == '*' || c == '_') && (c == '*' || i == 0 || !isWordByte(s[i-1])):
+			delim, mark := string(c), "em"
+			if strings.HasPrefix(s[i:], strings.Repeat(delim, 2)) {
+				delim, mark = strings.Repeat(delim, 2), "strong"
+			}
+			if end := closingDelimiter(s, i+len(delim), delim); end > i+len(delim) {
+				flush()
+				inner := append(append([]string{}, marks...), mark)
+				nodes = append(nodes, parseMarkdownInlineMarks(s[i+len(delim):end], inner)...)
+				i = end + len(delim)
+				continue
+			}
+		}
+		plain.WriteByte(c)
+		i++
+	}
+	flush()
+	return nodes
+}
+
+// closingDelimiter returns the index of the delimiter closing an emphasis opened
+// before s[from], or -1. A single delimiter does not match half of a double one,
+// a double one closes on the last two of a run of three, and an underscore only
+// closes at the end of a word.
+func closingDelimiter(s string, from int, delim string) int {
+	for i := from; i < len(s); i++ {
+		if s[i] == '`' {
+			// Delimiters inside inline code do not count
+			if end := strings.IndexByte(s[i+1:], '`'); end >= 0 {
+				i += end + 1
+				continue
+			}
+		}
+		if !strings.HasPrefix(s[i:], delim) {
+			continue
+		}
+		if len(delim) == 1 && i+1 < len(s) && s[i+1] == delim[0] {
+			i++ // Skip over a double delimiter
+			continue
+		}
+		if len(delim) == 2 && i+2 < len(s) && s[i+2] == delim[0] {
+			continue // In "***", the last two close a double delimiter
+		}
+		if delim[0] == '_' && i+len(delim) < len(s) && isWordByte(s[i+len(delim)]) {
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+// adfText returns an ADF text node with the given marks.
+func adfText(text string, marks []string) map[string]interface{} {
+	node := map[string]interface{}{"type": "text", "text": text}
+	if len(marks) > 0 {
+		nodeMarks := make([]map[string]interface{}, 0, len(marks))
+		for _, mark := range marks {
+			nodeMarks = append(nodeMarks, map[string]interface{}{"type": mark})
+		}
+		node["marks"] = nodeMarks
+	}
+	return node
+}
+
+// adfParagraph returns an ADF paragraph node with the given inline content.
+func adfParagraph(content []map[string]interface{}) map[string]interface{} {
+	if content == nil {
+		content = []map[string]interface{}{}
+	}
+	return map[string]interface{}{"type": "paragraph", "content": content}
+}
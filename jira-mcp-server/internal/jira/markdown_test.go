@@ -0,0 +1,209 @@
+package jira_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// createdDescription creates an issue with a Markdown description and returns the
+// "content" of the ADF description sent to JIRA, as JSON.
+func createdDescription(t *testing.T, markdown string) string {
+	t.Helper()
+	var content json.RawMessage
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Fields struct {
+				Description struct {
+					Type    string          `json:"type"`
+					Content json.RawMessage `json:"content"`
+				} `json:"description"`
+			} `json:"fields"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "doc", payload.Fields.Description.Type)
+		content = payload.Fields.Description.Content
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"10000","key":"PROJ-1"}`))
+	}
+
+	server, client := setupTestServer(t, withIssueTypes(handler))
+	defer server.Close()
+
+	_, err := client.CreateIssue(context.Background(), jira.CreateIssueRequest{
+		ProjectKey:        "PROJ",
+		Summary:           "Markdown",
+		IssueType:         "Task",
+		Description:       markdown,
+		DescriptionFormat: jira.TextFormatMarkdown,
+	})
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestClient_CreateIssue_MarkdownDescription(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "Headings",
+			markdown: "# Title\n### Steps ###",
+			want: `[
+				{"type":"heading","attrs":{"level":1},"content":[{"type":"text","text":"Title"}]},
+				{"type":"heading","attrs":{"level":3},"content":[{"type":"text","text":"Steps"}]}
+			]`,
+		},
+		{
+			name:     "Bold And Italic",
+			markdown: "A **bold** and *italic* and __also bold__ and _also italic_ word",
+			want: `[{"type":"paragraph","content":[
+				{"type":"text","text":"A "},
+				{"type":"text","text":"bold","marks":[{"type":"strong"}]},
+				{"type":"text","text":" and "},
+				{"type":"text","text":"italic","marks":[{"type":"em"}]},
+				{"type":"text","text":" and "},
+				{"type":"text","text":"also bold","marks":[{"type":"strong"}]},
+				{"type":"text","text":" and "},
+				{"type":"text","text":"also italic","marks":[{"type":"em"}]},
+				{"type":"text","text":" word"}
+			]}]`,
+		},
+		{
+			name:     "Nested Emphasis",
+			markdown: "**bold *both***",
+			want: `[{"type":"paragraph","content":[
+				{"type":"text","text":"bold ","marks":[{"type":"strong"}]},
+				{"type":"text","text":"both","marks":[{"type":"strong"},{"type":"em"}]}
+			]}]`,
+		},
+		{
+			name:     "Inline Code",
+			markdown: "Run `go test *_test.go` now",
+			want: `[{"type":"paragraph","content":[
+				{"type":"text","text":"Run "},
+				{"type":"text","text":"go test *_test.go","marks":[{"type":"code"}]},
+				{"type":"text","text":" now"}
+			]}]`,
+		},
+		{
+			name:     "Literal Delimiters",
+			markdown: "snake_case_name and 2 * 3 and **unclosed",
+			want:     `[{"type":"paragraph","content":[{"type":"text","text":"snake_case_name and 2 * 3 and **unclosed"}]}]`,
+		},
+		{
+			name:     "Fenced Code Block",
+			markdown: "```go\nfunc main() {\n\t// **not bold**\n}\n```\nAfter",
+			want: `[
+				{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"func main() {\n\t// **not bold**\n}"}]},
+				{"type":"paragraph","content":[{"type":"text","text":"After"}]}
+			]`,
+		},
+		{
+			name:     "Code Block Without Language",
+			markdown: "~~~\nplain\n~~~",
+			want:     `[{"type":"codeBlock","content":[{"type":"text","text":"plain"}]}]`,
+		},
+		{
+			name:     "Bullet List",
+			markdown: "- one\n* **two**\n+ three",
+			want: `[{"type":"bulletList","content":[
+				{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"one"}]}]},
+				{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"two","marks":[{"type":"strong"}]}]}]},
+				{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"three"}]}]}
+			]}]`,
+		},
+		{
+			name:     "Ordered List",
+			markdown: "3. three\n4) four",
+			want: `[{"type":"orderedList","attrs":{"order":3},"content":[
+				{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"three"}]}]},
+				{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"four"}]}]}
+			]}]`,
+		},
+		{
+			name:     "Nested List And Continuation",
+			markdown: "1. first\n   continued\n   - sub\n2. second",
+			want: `[{"type":"orderedList","attrs":{"order":1},"content":[
+				{"type":"listItem","content":[
+					{"type":"paragraph","content":[{"type":"text","text":"first"},{"type":"hardBreak"},{"type":"text","text":"continued"}]},
+					{"type":"bulletList","content":[
+						{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"sub"}]}]}
+					]}
+				]},
+				{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"second"}]}]}
+			]}]`,
+		},
+		{
+			name:     "Paragraphs Keep Line Breaks",
+			markdown: "line one\r\nline two\n\nnext paragraph\n- item",
+			want: `[
+				{"type":"paragraph","content":[{"type":"text","text":"line one"},{"type":"hardBreak"},{"type":"text","text":"line two"}]},
+				{"type":"paragraph","content":[{"type":"text","text":"next paragraph"}]},
+				{"type":"bulletList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"item"}]}]}]}
+			]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.JSONEq(t, tc.want, createdDescription(t, tc.markdown))
+		})
+	}
+
+	t.Run("Block Cap", func(t *testing.T) {
+		blocks := make([]string, jira.MaxADFParagraphs+5)
+		for i := range blocks {
+			blocks[i] = fmt.Sprintf("# Heading %d", i)
+		}
+
+		var content []map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(createdDescription(t, strings.Join(blocks, "\n"))), &content))
+
+		require.Len(t, content, jira.MaxADFParagraphs)
+		last := content[len(content)-1]
+		assert.Equal(t, "paragraph", last["type"])
+		assert.Contains(t, fmt.Sprint(last["content"]), fmt.Sprintf("# Heading %d", jira.MaxADFParagraphs+4), "overflow should be folded into the last block")
+	})
+}
+
+func TestClient_CreateIssue_UnsupportedDescriptionFormat(t *testing.T) {
+	server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("JIRA should not be called")
+	})
+	defer server.Close()
+
+	_, err := client.CreateIssue(context.Background(), jira.CreateIssueRequest{
+		ProjectKey: "PROJ", Summary: "S", IssueType: "Task", Description: "d", DescriptionFormat: "html",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported description format "html"`)
+}
+
+func TestClient_AddComment_MarkdownBody(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"body":{"type":"doc","version":1,"content":[
+			{"type":"paragraph","content":[{"type":"text","text":"Fixed in "},{"type":"text","text":"main","marks":[{"type":"code"}]}]}
+		]}}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"10000"}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	_, err := client.AddComment(context.Background(), "PROJ-1", jira.AddCommentRequest{Body: "Fixed in `main`", BodyFormat: jira.TextFormatMarkdown})
+	require.NoError(t, err)
+}
@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"net/http"
+	"strings"
+)
+
+// CallObserver is notified of every HTTP call the client makes to JIRA, including
+// retried attempts. operation is "create", "search", "get" or "other"; statusClass
+// is "2xx", "3xx", "4xx", "5xx", or "error" if no response was received.
+type CallObserver func(operation, statusClass string)
+
+// observeCall reports a call to the client's CallObserver, if any.
+func (c *Client) observeCall(httpReq *http.Request, resp *http.Response, err error) {
+	if c.callObserver == nil {
+		return
+	}
+	c.callObserver(callOperation(httpReq.Method, c.restPath(httpReq.URL.Path)), statusClass(resp, err))
+}
+
+// callOperation classifies a JIRA API call by method and path.
+func callOperation(method, path string) string {
+	switch {
+	case path == "/rest/api/3/issue" && method == http.MethodPost:
+		return "create"
+	case path == "/rest/api/3/search" || path == "/rest/api/3/search/jql":
+		return "search"
+	case strings.HasPrefix(path, "/rest/api/3/issue/") && !strings.Contains(strings.TrimPrefix(path, "/rest/api/3/issue/"), "/") && method == http.MethodGet:
+		return "get"
+	default:
+		return "other"
+	}
+}
+
+// statusClass returns the class of resp's status code, e.g. "4xx".
+func statusClass(resp *http.Response, err error) string {
+	if err != nil || resp == nil {
+		return "error"
+	}
+	switch {
+	case resp.StatusCode >= 500:
+		return "5xx"
+	case resp.StatusCode >= 400:
+		return "4xx"
+	case resp.StatusCode >= 300:
+		return "3xx"
+	default:
+		return "2xx"
+	}
+}
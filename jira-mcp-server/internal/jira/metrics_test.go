@@ -0,0 +1,51 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_CallObserver(t *testing.T) {
+	server, _ := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/api/3/issue" && r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"10001","key":"PROJ-1","self":"http://jira/rest/api/3/issue/10001"}`))
+		case r.URL.Path == "/rest/api/3/search":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errorMessages":["Error in the JQL Query"]}`))
+		case r.URL.Path == "/rest/api/3/issue/PROJ-1":
+			_, _ = w.Write([]byte(`{"key":"PROJ-1","fields":{}}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	var calls []string
+	cfg := dummyConfig
+	cfg.BaseURL = server.URL
+	cfg.CallObserver = func(operation, statusClass string) {
+		calls = append(calls, operation+" "+statusClass)
+	}
+	client, err := jira.NewClient(cfg, server.Client())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = client.CreateIssue(ctx, jira.CreateIssueRequest{ProjectKey: "PROJ", Summary: "S", IssueType: "10001"})
+	require.NoError(t, err)
+	_, err = client.SearchIssues(ctx, "project = PROJ", 0, 50, nil)
+	require.Error(t, err)
+	_, err = client.GetIssue(ctx, "PROJ-1", nil)
+	require.NoError(t, err)
+	_, err = client.GetTransitions(ctx, "PROJ-1")
+	require.Error(t, err)
+
+	assert.Equal(t, []string{"create 2xx", "search 4xx", "get 2xx", "other 5xx"}, calls)
+}
@@ -0,0 +1,49 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Permission keys checked by the server when reporting what the configured account can do.
+const (
+	PermissionCreateIssues     = "CREATE_ISSUES"
+	PermissionEditIssues       = "EDIT_ISSUES"
+	PermissionTransitionIssues = "TRANSITION_ISSUES"
+	PermissionAddComments      = "ADD_COMMENTS"
+)
+
+// Permission describes one permission and whether the current user has it.
+type Permission struct {
+	ID             string `json:"id"`
+	Key            string `json:"key"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Description    string `json:"description"`
+	HavePermission bool   `json:"havePermission"`
+}
+
+// GetMyPermissions returns the given permissions of the authenticated user, keyed by
+// permission key, using GET /rest/api/3/mypermissions. If projectKey is set, project
+// permissions are evaluated in that project; otherwise in any project.
+func (c *Client) GetMyPermissions(ctx context.Context, projectKey string, permissionKeys []string) (map[string]Permission, error) {
+	if len(permissionKeys) == 0 {
+		return nil, fmt.Errorf("at least one permission key is required")
+	}
+
+	params := url.Values{}
+	params.Set("permissions", strings.Join(permissionKeys, ","))
+	if projectKey != "" {
+		params.Set("projectKey", projectKey)
+	}
+
+	var resp struct {
+		Permissions map[string]Permission `json:"permissions"`
+	}
+	if err := c.doJSON(ctx, "GET", "/rest/api/3/mypermissions?"+params.Encode(), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Permissions, nil
+}
@@ -0,0 +1,36 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_GetMyPermissions(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/3/mypermissions", r.URL.Path)
+		assert.Equal(t, "PROJ", r.URL.Query().Get("projectKey"))
+		assert.Equal(t, "CREATE_ISSUES,ADD_COMMENTS", r.URL.Query().Get("permissions"))
+		_, _ = w.Write([]byte(`{"permissions":{
+			"CREATE_ISSUES":{"id":"11","key":"CREATE_ISSUES","name":"Create Issues","type":"PROJECT","description":"Ability to create issues.","havePermission":true},
+			"ADD_COMMENTS":{"id":"15","key":"ADD_COMMENTS","name":"Add Comments","type":"PROJECT","description":"Ability to comment on issues.","havePermission":false}
+		}}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	perms, err := client.GetMyPermissions(context.Background(), "PROJ", []string{jira.PermissionCreateIssues, jira.PermissionAddComments})
+
+	require.NoError(t, err)
+	require.Len(t, perms, 2)
+	assert.True(t, perms[jira.PermissionCreateIssues].HavePermission)
+	assert.False(t, perms[jira.PermissionAddComments].HavePermission)
+	assert.Equal(t, "Add Comments", perms[jira.PermissionAddComments].Name)
+}
@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// projectPageSize is the page size requested from /rest/api/3/project/search.
+const projectPageSize = 50
+
+// Project is a JIRA project visible to the authenticated user.
+type Project struct {
+	ID             string `json:"id"`
+	Key            string `json:"key"`
+	Name           string `json:"name"`
+	ProjectTypeKey string `json:"projectTypeKey"`
+}
+
+// projectSearchPage is one page of GET /rest/api/3/project/search.
+type projectSearchPage struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	IsLast     bool      `json:"isLast"`
+	Values     []Project `json:"values"`
+}
+
+// GetProjects returns every project visible to the authenticated user using
+// GET /rest/api/3/project/search, following its pages until isLast.
+func (c *Client) GetProjects(ctx context.Context) ([]Project, error) {
+	projects := []Project{}
+	for startAt := 0; ; {
+		query := url.Values{
+			"startAt":    {strconv.Itoa(startAt)},
+			"maxResults": {strconv.Itoa(projectPageSize)},
+		}
+		var page projectSearchPage
+		if err := c.doJSON(ctx, "GET", "/rest/api/3/project/search?"+query.Encode(), nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to list projects at startAt %d: %w", startAt, err)
+		}
+		projects = append(projects, page.Values...)
+		// An empty page also ends the listing so a misbehaving server cannot loop us forever
+		if page.IsLast || len(page.Values) == 0 {
+			return projects, nil
+		}
+		startAt += len(page.Values)
+	}
+}
@@ -0,0 +1,66 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_GetProjects(t *testing.T) {
+	t.Run("Follows Pages Until isLast", func(t *testing.T) {
+		var startAts []string
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "/rest/api/3/project/search", r.URL.Path)
+			startAts = append(startAts, r.URL.Query().Get("startAt"))
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("startAt") == "0" {
+				_, _ = w.Write([]byte(`{"startAt":0,"maxResults":2,"total":3,"isLast":false,"values":[
+					{"id":"10000","key":"PROJ","name":"Project","projectTypeKey":"software","self":"http://fakejira.com/rest/api/3/project/10000"},
+					{"id":"10001","key":"OPS","name":"Operations","projectTypeKey":"service_desk"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"startAt":2,"maxResults":2,"total":3,"isLast":true,"values":[
+				{"id":"10002","key":"BIZ","name":"Business","projectTypeKey":"business"}]}`))
+		})
+		defer server.Close()
+
+		projects, err := client.GetProjects(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0", "2"}, startAts)
+		assert.Equal(t, []jira.Project{
+			{ID: "10000", Key: "PROJ", Name: "Project", ProjectTypeKey: "software"},
+			{ID: "10001", Key: "OPS", Name: "Operations", ProjectTypeKey: "service_desk"},
+			{ID: "10002", Key: "BIZ", Name: "Business", ProjectTypeKey: "business"},
+		}, projects)
+	})
+
+	t.Run("No Projects", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":0,"isLast":true,"values":[]}`))
+		})
+		defer server.Close()
+
+		projects, err := client.GetProjects(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, projects)
+		assert.NotNil(t, projects, "An empty list should encode as [] rather than null")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		defer server.Close()
+
+		_, err := client.GetProjects(context.Background())
+		var apiErr *jira.JiraAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	})
+}
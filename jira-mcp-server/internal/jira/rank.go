@@ -0,0 +1,63 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// MaxRankIssues is the maximum number of issues JIRA accepts in a single rank request.
+const MaxRankIssues = 50
+
+// RankIssuesRequest defines which issues to re-rank and where to move them.
+// Exactly one of RankBeforeIssue or RankAfterIssue must be set.
+type RankIssuesRequest struct {
+	Issues          []string `json:"issues"`
+	RankBeforeIssue string   `json:"rankBeforeIssue,omitempty"`
+	RankAfterIssue  string   `json:"rankAfterIssue,omitempty"`
+}
+
+// rankResponse is returned by JIRA with status 207 when some issues could not be ranked.
+type rankResponse struct {
+	Entries []struct {
+		IssueKey string   `json:"issueKey"`
+		Status   int      `json:"status"`
+		Errors   []string `json:"errors"`
+	} `json:"entries"`
+}
+
+// RankIssues moves the given issues before or after an anchor issue using
+// PUT /rest/agile/1.0/issue/rank. The issues keep their relative order.
+// If JIRA reports a partial failure, an error listing the failed issues is returned.
+func (c *Client) RankIssues(ctx context.Context, req RankIssuesRequest) error {
+	if len(req.Issues) == 0 {
+		return fmt.Errorf("at least one issue key is required")
+	}
+	if len(req.Issues) > MaxRankIssues {
+		return fmt.Errorf("at most %d issues can be ranked at once", MaxRankIssues)
+	}
+	if (req.RankBeforeIssue == "") == (req.RankAfterIssue == "") {
+		return fmt.Errorf("exactly one of rankBeforeIssue or rankAfterIssue must be set")
+	}
+	defer func() {
+		for _, issueKey := range req.Issues {
+			c.issueCache.invalidate(issueKey)
+		}
+	}()
+
+	var resp rankResponse
+	if err := c.doJSON(ctx, "PUT", "/rest/agile/1.0/issue/rank", req, &resp); err != nil {
+		return err
+	}
+
+	var failures []string
+	for _, entry := range resp.Entries {
+		if entry.Status >= 300 {
+			failures = append(failures, fmt.Sprintf("%s (%s)", entry.IssueKey, strings.Join(entry.Errors, "; ")))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to rank issues: %s", strings.Join(failures, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package jira_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_RankIssues(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Rank before", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "PUT", r.Method)
+			assert.Equal(t, "/rest/agile/1.0/issue/rank", r.URL.Path)
+			var payload map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			assert.Equal(t, map[string]interface{}{
+				"issues":          []interface{}{"PROJ-2", "PROJ-3"},
+				"rankBeforeIssue": "PROJ-1",
+			}, payload)
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.RankIssues(ctx, jira.RankIssuesRequest{Issues: []string{"PROJ-2", "PROJ-3"}, RankBeforeIssue: "PROJ-1"})
+		require.NoError(t, err)
+	})
+
+	t.Run("Rank after", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			var payload map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			assert.Equal(t, map[string]interface{}{
+				"issues":         []interface{}{"PROJ-2"},
+				"rankAfterIssue": "PROJ-9",
+			}, payload)
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.RankIssues(ctx, jira.RankIssuesRequest{Issues: []string{"PROJ-2"}, RankAfterIssue: "PROJ-9"})
+		require.NoError(t, err)
+	})
+
+	t.Run("Partial failure", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusMultiStatus)
+			_, _ = w.Write([]byte(`{"entries":[
+				{"issueId":10002,"issueKey":"PROJ-2","status":204},
+				{"issueId":10003,"issueKey":"PROJ-3","status":403,"errors":["No permission"]}
+			]}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.RankIssues(ctx, jira.RankIssuesRequest{Issues: []string{"PROJ-2", "PROJ-3"}, RankBeforeIssue: "PROJ-1"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "PROJ-3 (No permission)")
+		assert.NotContains(t, err.Error(), "PROJ-2")
+	})
+
+	t.Run("Both anchors set", func(t *testing.T) {
+		client, err := jira.NewClient(dummyConfig, nil)
+		require.NoError(t, err)
+
+		err = client.RankIssues(ctx, jira.RankIssuesRequest{Issues: []string{"PROJ-2"}, RankBeforeIssue: "PROJ-1", RankAfterIssue: "PROJ-3"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly one of rankBeforeIssue or rankAfterIssue")
+	})
+}
@@ -0,0 +1,133 @@
+package jira
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"jira-mcp-server/internal/clock"
+)
+
+// RateLimit configures a token bucket: on average Rate requests per second,
+// with bursts of up to Burst requests. A Rate of zero or less means unlimited.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// HostRateLimiter throttles outgoing JIRA API calls with a separate token bucket
+// per JIRA host, so that a busy instance cannot use up the allowance of another.
+// It is safe for concurrent use and may be shared by several clients.
+type HostRateLimiter struct {
+	clock   clock.Clock
+	limit   RateLimit
+	perHost map[string]RateLimit
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// tokenBucket is the state of one host's bucket.
+type tokenBucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// NewHostRateLimiter returns a limiter applying limit to every host except those
+// listed in perHost, which get their own setting. Hosts are matched case-insensitively
+// and include the port if the JIRA URL has one. If clk is nil the real clock is used.
+func NewHostRateLimiter(limit RateLimit, perHost map[string]RateLimit, clk clock.Clock) *HostRateLimiter {
+	if clk == nil {
+		clk = clock.Real{}
+	}
+	hosts := make(map[string]RateLimit, len(perHost))
+	for host, l := range perHost {
+		hosts[strings.ToLower(host)] = l
+	}
+	return &HostRateLimiter{
+		clock:   clk,
+		limit:   limit,
+		perHost: hosts,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// Wait blocks until a call to host is allowed. If the wait would outlast ctx's
+// deadline it returns context.DeadlineExceeded immediately without using a token,
+// and if ctx is cancelled while waiting it returns ctx's error promptly and gives
+// the token back.
+func (l *HostRateLimiter) Wait(ctx context.Context, host string) error {
+	host = strings.ToLower(host)
+	deadline, _ := ctx.Deadline()
+	delay, err := l.reserve(host, deadline)
+	if err != nil {
+		return err
+	}
+	if delay > 0 {
+		if err := l.clock.SleepContext(ctx, delay); err != nil {
+			l.release(host)
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// reserve takes a token from host's bucket and returns how long the caller must wait
+// before the token becomes valid. The bucket may go negative, which queues callers.
+func (l *HostRateLimiter) reserve(host string, deadline time.Time) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	b, ok := l.buckets[host]
+	if !ok {
+		limit, ok := l.perHost[host]
+		if !ok {
+			limit = l.limit
+		}
+		if limit.Burst < 1 {
+			limit.Burst = 1
+		}
+		b = &tokenBucket{limit: limit, tokens: float64(limit.Burst), last: now}
+		l.buckets[host] = b
+	}
+	if b.limit.Rate <= 0 {
+		return 0, nil
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * b.limit.Rate
+	if b.tokens > float64(b.limit.Burst) {
+		b.tokens = float64(b.limit.Burst)
+	}
+	b.last = now
+
+	var delay time.Duration
+	if b.tokens < 1 {
+		delay = time.Duration((1 - b.tokens) / b.limit.Rate * float64(time.Second))
+	}
+	if !deadline.IsZero() && now.Add(delay).After(deadline) {
+		return 0, context.DeadlineExceeded
+	}
+	b.tokens--
+	return delay, nil
+}
+
+// release returns a token taken by reserve to host's bucket.
+func (l *HostRateLimiter) release(host string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if b, ok := l.buckets[host]; ok && b.limit.Rate > 0 {
+		b.tokens = min(b.tokens+1, float64(b.limit.Burst))
+	}
+}
+
+// waitForRateLimit blocks until the client's JIRA host may be called, if the client
+// has a rate limiter.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.rateLimiter == nil {
+		return nil
+	}
+	return c.rateLimiter.Wait(ctx, c.host)
+}
@@ -0,0 +1,114 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/clock"
+	"jira-mcp-server/internal/jira"
+)
+
+func TestHostRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Hosts Have Independent Buckets", func(t *testing.T) {
+		fake := clock.NewFake(start)
+		limiter := jira.NewHostRateLimiter(jira.RateLimit{Rate: 1, Burst: 2}, nil, fake)
+
+		// Saturate the first host's bucket; the third call has to wait for a refill
+		for i := 0; i < 3; i++ {
+			require.NoError(t, limiter.Wait(ctx, "a.atlassian.net"))
+		}
+		assert.Equal(t, []time.Duration{time.Second}, fake.Slept())
+
+		// The second host still has its full burst available
+		require.NoError(t, limiter.Wait(ctx, "b.atlassian.net"))
+		require.NoError(t, limiter.Wait(ctx, "B.atlassian.net"))
+		assert.Equal(t, []time.Duration{time.Second}, fake.Slept(), "Calls to another host must not be throttled")
+	})
+
+	t.Run("Per Host Override", func(t *testing.T) {
+		fake := clock.NewFake(start)
+		limiter := jira.NewHostRateLimiter(jira.RateLimit{Rate: 1, Burst: 1}, map[string]jira.RateLimit{
+			"busy.atlassian.net": {Rate: 4, Burst: 1},
+			"free.atlassian.net": {},
+		}, fake)
+
+		require.NoError(t, limiter.Wait(ctx, "busy.atlassian.net"))
+		require.NoError(t, limiter.Wait(ctx, "busy.atlassian.net"))
+		for i := 0; i < 5; i++ {
+			require.NoError(t, limiter.Wait(ctx, "free.atlassian.net"))
+		}
+		assert.Equal(t, []time.Duration{250 * time.Millisecond}, fake.Slept())
+	})
+
+	t.Run("Wait Beyond Deadline", func(t *testing.T) {
+		// Deadlines are real times, so the fake clock starts at the real current time
+		fake := clock.NewFake(time.Now())
+		limiter := jira.NewHostRateLimiter(jira.RateLimit{Rate: 0.1, Burst: 1}, nil, fake)
+
+		require.NoError(t, limiter.Wait(ctx, "a.atlassian.net"))
+
+		// The next token is 10s away, well beyond the deadline
+		deadlineCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		assert.ErrorIs(t, limiter.Wait(deadlineCtx, "a.atlassian.net"), context.DeadlineExceeded)
+		assert.Empty(t, fake.Slept())
+	})
+
+	t.Run("Cancelled While Waiting", func(t *testing.T) {
+		limiter := jira.NewHostRateLimiter(jira.RateLimit{Rate: 0.1, Burst: 1}, nil, clock.Real{})
+		require.NoError(t, limiter.Wait(ctx, "a.atlassian.net"))
+
+		// The next token is 10s away; cancelling must end the wait right away
+		cancelCtx, cancel := context.WithCancel(ctx)
+		time.AfterFunc(20*time.Millisecond, cancel)
+		started := time.Now()
+		assert.ErrorIs(t, limiter.Wait(cancelCtx, "a.atlassian.net"), context.Canceled)
+		assert.Less(t, time.Since(started), 2*time.Second)
+	})
+
+	t.Run("Cancelled Wait Returns Its Token", func(t *testing.T) {
+		fake := clock.NewFake(start)
+		limiter := jira.NewHostRateLimiter(jira.RateLimit{Rate: 1, Burst: 1}, nil, fake)
+		require.NoError(t, limiter.Wait(ctx, "a.atlassian.net"))
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, limiter.Wait(cancelled, "a.atlassian.net"), context.Canceled)
+
+		// Without the refund, this call would queue behind the abandoned one and wait 2s
+		require.NoError(t, limiter.Wait(ctx, "a.atlassian.net"))
+		assert.Equal(t, []time.Duration{time.Second}, fake.Slept())
+	})
+}
+
+func TestClient_RateLimited(t *testing.T) {
+	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	limiter := jira.NewHostRateLimiter(jira.RateLimit{Rate: 2, Burst: 1}, nil, fake)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"permissions":{}}`))
+	}
+	server, _ := setupTestServer(t, handler)
+	defer server.Close()
+
+	cfg := dummyConfig
+	cfg.BaseURL = server.URL
+	cfg.RateLimiter = limiter
+	client, err := jira.NewClient(cfg, server.Client())
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetMyPermissions(context.Background(), "", []string{jira.PermissionCreateIssues})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []time.Duration{500 * time.Millisecond}, fake.Slept())
+}
@@ -0,0 +1,156 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a request answered with 429 Too Many
+	// Requests is retried when the configuration does not say otherwise.
+	DefaultMaxRetries = 3
+
+	// retryBaseDelay and retryMaxDelay bound the exponential backoff used when a
+	// 429 response carries no usable Retry-After header.
+	retryBaseDelay = time.Second
+	retryMaxDelay  = 30 * time.Second
+)
+
+// RequestTimeoutError is returned when a single JIRA call does not complete within
+// the client's RequestTimeout. It unwraps to context.DeadlineExceeded.
+type RequestTimeoutError struct {
+	Timeout time.Duration
+	URL     string
+}
+
+func (e *RequestTimeoutError) Error() string {
+	return fmt.Sprintf("JIRA did not respond within %s (URL: %s)", e.Timeout, e.URL)
+}
+
+func (e *RequestTimeoutError) Unwrap() error { return context.DeadlineExceeded }
+
+// cancelOnClose releases a per-attempt timeout context once the response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// send spends the request's call budget, waits for the rate limiter and sends httpReq.
+// Each attempt must complete within the client's requestTimeout, if set, including
+// reading the response body; an attempt that runs out of time fails with a
+// *RequestTimeoutError.
+// A 429 Too Many Requests response is retried up to the client's maxRetries times,
+// waiting as long as its Retry-After header asks or, without one, backing off
+// exponentially. Retries stop early if the wait would pass the context's deadline or
+// the request body cannot be replayed; the last 429 response is then returned.
+// The call, including its retries, is recorded as a tracing span.
+func (c *Client) send(ctx context.Context, httpReq *http.Request) (*http.Response, error) {
+	ctx, span := c.startCallSpan(ctx, httpReq)
+	resp, err := c.sendWithRetries(ctx, httpReq)
+	endCallSpan(span, resp, err)
+	return resp, err
+}
+
+// sendWithRetries implements send.
+func (c *Client) sendWithRetries(ctx context.Context, httpReq *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := spendCallBudget(ctx); err != nil {
+			return nil, err
+		}
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+		resp, err := c.sendAttempt(ctx, httpReq)
+		c.observeCall(httpReq, resp, err)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= c.maxRetries {
+			return resp, err
+		}
+
+		delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), c.clock.Now())
+		if !ok {
+			delay = backoffDelay(attempt)
+		}
+		if deadline, hasDeadline := ctx.Deadline(); hasDeadline && c.clock.Now().Add(delay).After(deadline) {
+			return resp, nil
+		}
+		if httpReq.Body != nil && httpReq.GetBody == nil {
+			return resp, nil
+		}
+
+		_ = resp.Body.Close()
+		c.logger.WarnContext(ctx, "JIRA rate limit hit; retrying", "url", httpReq.URL.String(), "attempt", attempt+1, "delay", delay)
+		if err := c.clock.SleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+		if httpReq.GetBody != nil {
+			body, err := httpReq.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			httpReq.Body = body
+		}
+	}
+}
+
+// sendAttempt sends httpReq once, bounded by the client's requestTimeout.
+func (c *Client) sendAttempt(ctx context.Context, httpReq *http.Request) (*http.Response, error) {
+	if c.requestTimeout <= 0 {
+		return c.httpClient.Do(httpReq)
+	}
+	attemptCtx, cancel := context.WithTimeout(httpReq.Context(), c.requestTimeout)
+	resp, err := c.httpClient.Do(httpReq.WithContext(attemptCtx))
+	if err != nil {
+		cancel()
+		// Only the per-attempt deadline is reported as a timeout; the caller's own
+		// deadline or cancellation is passed through unchanged
+		if errors.Is(attemptCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			return nil, &RequestTimeoutError{Timeout: c.requestTimeout, URL: httpReq.URL.String()}
+		}
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// parseRetryAfter parses a Retry-After header given either as a number of seconds
+// or as an HTTP date, returning how long to wait from now.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if delay := at.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// backoffDelay returns the exponential backoff before retry number attempt+1.
+func backoffDelay(attempt int) time.Duration {
+	delay := retryBaseDelay << attempt
+	if delay > retryMaxDelay || delay <= 0 {
+		return retryMaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,203 @@
+package jira_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/clock"
+	"jira-mcp-server/internal/jira"
+)
+
+// newRetryingClient returns a client for server that retries 429s up to maxRetries
+// times, sleeping on a fake clock.
+func newRetryingClient(t *testing.T, serverURL string, httpClient *http.Client, maxRetries int) (*jira.Client, *clock.Fake) {
+	t.Helper()
+	fake := clock.NewFake(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	cfg := dummyConfig
+	cfg.BaseURL = serverURL
+	cfg.Clock = fake
+	cfg.MaxRetries = maxRetries
+	client, err := jira.NewClient(cfg, httpClient)
+	require.NoError(t, err)
+	return client, fake
+}
+
+func TestClient_Retry429(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Retry-After Seconds", func(t *testing.T) {
+		calls := 0
+		server, _ := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("Retry-After", "7")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte(`{"key":"PROJ-1","fields":{}}`))
+		})
+		defer server.Close()
+		client, fake := newRetryingClient(t, server.URL, server.Client(), 3)
+
+		issue, err := client.GetIssue(ctx, "PROJ-1", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "PROJ-1", issue.Key)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, []time.Duration{7 * time.Second}, fake.Slept())
+	})
+
+	t.Run("Retry-After HTTP Date", func(t *testing.T) {
+		calls := 0
+		server, _ := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("Retry-After", "Wed, 01 May 2024 12:00:20 GMT")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":0,"issues":[]}`))
+		})
+		defer server.Close()
+		client, fake := newRetryingClient(t, server.URL, server.Client(), 3)
+
+		_, err := client.SearchIssues(ctx, "project = PROJ", 0, 50, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{20 * time.Second}, fake.Slept())
+	})
+
+	t.Run("Body Replayed", func(t *testing.T) {
+		var bodies []string
+		server, _ := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			if len(bodies) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"10001","key":"PROJ-1"}`))
+		})
+		defer server.Close()
+		client, _ := newRetryingClient(t, server.URL, server.Client(), 3)
+
+		_, err := client.CreateIssue(ctx, jira.CreateIssueRequest{ProjectKey: "PROJ", Summary: "Retry me", IssueType: "10001"})
+		require.NoError(t, err)
+		require.Len(t, bodies, 2)
+		assert.Equal(t, bodies[0], bodies[1])
+	})
+
+	t.Run("Exponential Backoff Then Exhausted", func(t *testing.T) {
+		calls := 0
+		server, _ := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+		defer server.Close()
+		client, fake := newRetryingClient(t, server.URL, server.Client(), 3)
+
+		_, err := client.GetIssue(ctx, "PROJ-1", nil)
+		var apiErr *jira.JiraAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+		assert.Equal(t, 4, calls)
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}, fake.Slept())
+	})
+
+	t.Run("Wait Past Deadline Gives Up", func(t *testing.T) {
+		calls := 0
+		server, _ := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+		defer server.Close()
+		// The deadline is compared with the client's clock, so it must track real time here
+		fake := clock.NewFake(time.Now())
+		cfg := dummyConfig
+		cfg.BaseURL = server.URL
+		cfg.Clock = fake
+		cfg.MaxRetries = 3
+		client, err := jira.NewClient(cfg, server.Client())
+		require.NoError(t, err)
+
+		deadlineCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+		_, err = client.GetIssue(deadlineCtx, "PROJ-1", nil)
+		var apiErr *jira.JiraAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+		assert.Equal(t, 1, calls)
+		assert.Empty(t, fake.Slept())
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		calls := 0
+		server, _ := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+		defer server.Close()
+		client, _ := newRetryingClient(t, server.URL, server.Client(), 0)
+
+		_, err := client.GetIssue(ctx, "PROJ-1", nil)
+		require.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestClient_RequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server, _ := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = w.Write([]byte(`{"key":"PROJ-1","fields":{}}`))
+	})
+	defer server.Close()
+	defer close(release)
+
+	cfg := dummyConfig
+	cfg.BaseURL = server.URL
+	cfg.RequestTimeout = 20 * time.Millisecond
+	client, err := jira.NewClient(cfg, server.Client())
+	require.NoError(t, err)
+
+	_, err = client.GetIssue(context.Background(), "PROJ-1", nil)
+	var timeoutErr *jira.RequestTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, 20*time.Millisecond, timeoutErr.Timeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	t.Run("Caller Cancellation Not Reported As Timeout", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := client.GetIssue(ctx, "PROJ-1", nil)
+		require.Error(t, err)
+		assert.False(t, errors.As(err, &timeoutErr))
+	})
+
+	t.Run("Fast Response Body Readable", func(t *testing.T) {
+		fast, _ := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"key":"PROJ-2","fields":{}}`))
+		})
+		defer fast.Close()
+		cfg := dummyConfig
+		cfg.BaseURL = fast.URL
+		cfg.RequestTimeout = time.Second
+		client, err := jira.NewClient(cfg, fast.Client())
+		require.NoError(t, err)
+
+		issue, err := client.GetIssue(context.Background(), "PROJ-2", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "PROJ-2", issue.Key)
+	})
+}
@@ -0,0 +1,134 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Search APIs selectable for issue searches. SearchAPIOffset is the classic
+// /rest/api/3/search endpoint paged with startAt; SearchAPIJQL is its replacement
+// /rest/api/3/search/jql, paged with nextPageToken.
+const (
+	SearchAPIOffset = "search"
+	SearchAPIJQL    = "jql"
+)
+
+// navigableFields is requested when a token search names no fields, matching the
+// default of the offset search. /search/jql itself defaults to returning only IDs.
+var navigableFields = []string{"*navigable"}
+
+// SearchTokenResponse is one page of results from /rest/api/3/search/jql.
+// NextPageToken fetches the following page and is empty on the last one. The
+// endpoint reports no total.
+type SearchTokenResponse struct {
+	Issues        []Issue `json:"issues"`
+	NextPageToken string  `json:"nextPageToken,omitempty"`
+	IsLast        bool    `json:"isLast"`
+}
+
+// SearchIssuesToken runs a JQL search against POST /rest/api/3/search/jql and
+// returns one page of up to maxResults issues. pageToken is empty for the first
+// page and otherwise the NextPageToken of the previous page.
+func (c *Client) SearchIssuesToken(ctx context.Context, jql string, pageToken string, maxResults int, fields []string) (*SearchTokenResponse, error) {
+	if jql == "" {
+		return nil, fmt.Errorf("JQL query cannot be empty")
+	}
+	if len(fields) == 0 {
+		fields = navigableFields
+	}
+
+	payload := map[string]interface{}{
+		"jql":    jql,
+		"fields": fields,
+	}
+	if maxResults > 0 {
+		payload["maxResults"] = maxResults
+	}
+	if pageToken != "" {
+		payload["nextPageToken"] = pageToken
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal search request: %v", err)
+	}
+
+	url := c.requestURL("/rest/api/3/search/jql")
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create search request: %v", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	c.setAuth(httpReq)
+
+	resp, err := c.send(ctx, httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send search request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, newJiraAPIError(resp, url)
+	}
+	if err := checkNotLoginPage(resp, url); err != nil {
+		return nil, err
+	}
+
+	// Issues are decoded like those of the offset search, keeping numbers exact
+	var page SearchTokenResponse
+	if err := decodeIssueJSON(resp.Body, &page); err != nil {
+		return nil, fmt.Errorf("failed to decode search response: %v", err)
+	}
+	if page.Issues == nil {
+		page.Issues = []Issue{}
+	}
+	for i := range page.Issues {
+		c.populateSprints(&page.Issues[i])
+	}
+	return &page, nil
+}
+
+// SearchAllIssuesToken is the SearchAllIssues of the /rest/api/3/search/jql API: it
+// follows nextPageToken until the last page. Since that API reports no total, a
+// limit is checked up front against POST /rest/api/3/search/approximate-count, and
+// a *ResultSetTooLargeError is returned without fetching any issues if the count
+// exceeds it. A limit of zero means no limit.
+func (c *Client) SearchAllIssuesToken(ctx context.Context, jql string, fields []string, limit int) (*SearchResponse, error) {
+	if jql == "" {
+		return nil, fmt.Errorf("JQL query cannot be empty")
+	}
+	if limit > 0 {
+		var count struct {
+			Count int `json:"count"`
+		}
+		if err := c.doJSON(ctx, "POST", "/rest/api/3/search/approximate-count", map[string]string{"jql": jql}, &count); err != nil {
+			return nil, err
+		}
+		if count.Count > limit {
+			return nil, &ResultSetTooLargeError{Total: count.Count, Limit: limit}
+		}
+	}
+
+	all := &SearchResponse{Issues: []Issue{}}
+	for pageToken := ""; ; {
+		page, err := c.SearchIssuesToken(ctx, jql, pageToken, searchAllPageSize, fields)
+		if err != nil {
+			return nil, err
+		}
+		all.Issues = append(all.Issues, page.Issues...)
+		if limit > 0 && len(all.Issues) > limit {
+			// The count was approximate and the query matched more than allowed after all
+			return nil, &ResultSetTooLargeError{Total: len(all.Issues), Limit: limit}
+		}
+		if page.IsLast || page.NextPageToken == "" || len(page.Issues) == 0 {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+	all.Total = len(all.Issues)
+	all.MaxResults = len(all.Issues)
+	return all, nil
+}
@@ -0,0 +1,122 @@
+package jira_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_SearchIssuesToken(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("First Page", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"jql":"project = PROJ","fields":["summary"],"maxResults":2}`, string(body))
+
+			_, _ = w.Write([]byte(`{"issues":[{"key":"PROJ-1","fields":{"summary":"One","customfield_10016":3.5}},{"key":"PROJ-2","fields":{"summary":"Two"}}],
+				"nextPageToken":"CAEaAggD","isLast":false}`))
+		})
+		defer server.Close()
+
+		page, err := client.SearchIssuesToken(ctx, "project = PROJ", "", 2, []string{"summary"})
+		require.NoError(t, err)
+		require.Len(t, page.Issues, 2)
+		assert.Equal(t, "PROJ-1", page.Issues[0].Key)
+		assert.Equal(t, json.Number("3.5"), page.Issues[0].Fields["customfield_10016"], "Numbers should be decoded exactly")
+		assert.Equal(t, "CAEaAggD", page.NextPageToken)
+		assert.False(t, page.IsLast)
+	})
+
+	t.Run("Next Page With Default Fields", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"jql":"project = PROJ","fields":["*navigable"],"nextPageToken":"CAEaAggD"}`, string(body))
+
+			_, _ = w.Write([]byte(`{"issues":[],"isLast":true}`))
+		})
+		defer server.Close()
+
+		page, err := client.SearchIssuesToken(ctx, "project = PROJ", "CAEaAggD", 0, nil)
+		require.NoError(t, err)
+		assert.NotNil(t, page.Issues, "An empty page should encode as [] rather than null")
+		assert.Empty(t, page.NextPageToken)
+		assert.True(t, page.IsLast)
+	})
+
+	t.Run("Invalid JQL", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errorMessages":["Error in the JQL Query"],"errors":{}}`))
+		})
+		defer server.Close()
+
+		_, err := client.SearchIssuesToken(ctx, "project = = PROJ", "", 10, nil)
+		var apiErr *jira.JiraAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, "Error in the JQL Query", apiErr.Details())
+	})
+
+	t.Run("Empty JQL", func(t *testing.T) {
+		_, err := (&jira.Client{}).SearchIssuesToken(ctx, "", "", 10, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestClient_SearchAllIssuesToken(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Follows Tokens", func(t *testing.T) {
+		var calls []string
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			calls = append(calls, r.URL.Path)
+			switch {
+			case r.URL.Path == "/rest/api/3/search/approximate-count":
+				assert.JSONEq(t, `{"jql":"project = PROJ"}`, string(body))
+				_, _ = w.Write([]byte(`{"count":3}`))
+			case r.URL.Path == "/rest/api/3/search/jql" && !strings.Contains(string(body), "nextPageToken"):
+				_, _ = w.Write([]byte(`{"issues":[{"key":"PROJ-1","fields":{}},{"key":"PROJ-2","fields":{}}],"nextPageToken":"tok2","isLast":false}`))
+			case r.URL.Path == "/rest/api/3/search/jql":
+				assert.Contains(t, string(body), `"nextPageToken":"tok2"`)
+				_, _ = w.Write([]byte(`{"issues":[{"key":"PROJ-3","fields":{}}],"isLast":true}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+		defer server.Close()
+
+		resp, err := client.SearchAllIssuesToken(ctx, "project = PROJ", nil, 10)
+		require.NoError(t, err)
+		require.Len(t, resp.Issues, 3)
+		assert.Equal(t, 3, resp.Total)
+		assert.Equal(t, "PROJ-3", resp.Issues[2].Key)
+		assert.Equal(t, []string{"/rest/api/3/search/approximate-count", "/rest/api/3/search/jql", "/rest/api/3/search/jql"}, calls)
+	})
+
+	t.Run("Too Large", func(t *testing.T) {
+		server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/rest/api/3/search/approximate-count", r.URL.Path, "No issues should be fetched")
+			_, _ = w.Write([]byte(`{"count":1200}`))
+		})
+		defer server.Close()
+
+		_, err := client.SearchAllIssuesToken(ctx, "project = PROJ", nil, 1000)
+		var tooLarge *jira.ResultSetTooLargeError
+		require.ErrorAs(t, err, &tooLarge)
+		assert.Equal(t, 1200, tooLarge.Total)
+	})
+}
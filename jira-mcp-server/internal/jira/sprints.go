@@ -0,0 +1,92 @@
+package jira
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultSprintFieldName holds the JIRA custom field ID typically used for "Sprint".
+// NOTE: Like EpicLinkFieldName, this ID varies between JIRA instances; override it
+// via configuration if sprints are not being picked up.
+const DefaultSprintFieldName = "customfield_10020"
+
+// SprintRef identifies a sprint an issue belongs to.
+type SprintRef struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	State string `json:"state"` // "future", "active" or "closed"
+}
+
+// legacySprintAttr matches the "key=" markers in a legacy serialized sprint string.
+var legacySprintAttr = regexp.MustCompile(`(?:\[|,)(\w+)=`)
+
+// ParseSprints converts the raw value of the sprint custom field into SprintRefs.
+// JIRA Cloud returns an array of sprint objects, while older JIRA versions return
+// an array of serialized strings such as
+// "com.atlassian.greenhopper.service.sprint.Sprint@1a2b[id=1,rapidViewId=2,state=ACTIVE,name=Sprint 1,...]".
+// Both representations are supported; unrecognized entries are skipped.
+func ParseSprints(raw interface{}) []SprintRef {
+	values, ok := raw.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	sprints := make([]SprintRef, 0, len(values))
+	for _, value := range values {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			sprint := SprintRef{}
+			switch id := v["id"].(type) {
+			case float64:
+				sprint.ID = int(id)
+			case json.Number:
+				// Issue responses are decoded with UseNumber
+				if n, err := id.Int64(); err == nil {
+					sprint.ID = int(n)
+				}
+			}
+			sprint.Name, _ = v["name"].(string)
+			state, _ := v["state"].(string)
+			sprint.State = strings.ToLower(state)
+			sprints = append(sprints, sprint)
+		case string:
+			if sprint, ok := parseLegacySprint(v); ok {
+				sprints = append(sprints, sprint)
+			}
+		}
+	}
+	return sprints
+}
+
+// parseLegacySprint parses a serialized greenhopper sprint string.
+func parseLegacySprint(s string) (SprintRef, bool) {
+	open := strings.Index(s, "[")
+	closing := strings.LastIndex(s, "]")
+	if open < 0 || closing < open {
+		return SprintRef{}, false
+	}
+	body := s[open:closing]
+
+	// Values may contain commas (e.g. sprint names), so slice between attribute markers
+	attrs := make(map[string]string)
+	markers := legacySprintAttr.FindAllStringSubmatchIndex(body, -1)
+	for i, m := range markers {
+		end := len(body)
+		if i+1 < len(markers) {
+			end = markers[i+1][0]
+		}
+		attrs[body[m[2]:m[3]]] = body[m[1]:end]
+	}
+
+	id, err := strconv.Atoi(attrs["id"])
+	if err != nil {
+		return SprintRef{}, false
+	}
+	return SprintRef{
+		ID:    id,
+		Name:  attrs["name"],
+		State: strings.ToLower(attrs["state"]),
+	}, true
+}
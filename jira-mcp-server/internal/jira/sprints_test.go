@@ -0,0 +1,79 @@
+package jira_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func decodeRaw(t *testing.T, raw string) interface{} {
+	t.Helper()
+	var v interface{}
+	require.NoError(t, json.Unmarshal([]byte(raw), &v))
+	return v
+}
+
+func TestParseSprints(t *testing.T) {
+	t.Run("Object array", func(t *testing.T) {
+		raw := decodeRaw(t, `[
+			{"id":37,"name":"Sprint 12","state":"closed","boardId":5},
+			{"id":38,"name":"Sprint 13","state":"active","boardId":5}
+		]`)
+
+		sprints := jira.ParseSprints(raw)
+
+		assert.Equal(t, []jira.SprintRef{
+			{ID: 37, Name: "Sprint 12", State: "closed"},
+			{ID: 38, Name: "Sprint 13", State: "active"},
+		}, sprints)
+	})
+
+	t.Run("Legacy serialized strings", func(t *testing.T) {
+		raw := decodeRaw(t, `[
+			"com.atlassian.greenhopper.service.sprint.Sprint@1f3e2b[id=7,rapidViewId=2,state=CLOSED,name=Sprint 1, the beginning,startDate=2020-01-01T10:00:00.000Z,endDate=<null>,sequence=7]",
+			"com.atlassian.greenhopper.service.sprint.Sprint@9a8b7c[id=8,rapidViewId=2,state=ACTIVE,name=Sprint 2,startDate=<null>,endDate=<null>,sequence=8]"
+		]`)
+
+		sprints := jira.ParseSprints(raw)
+
+		assert.Equal(t, []jira.SprintRef{
+			{ID: 7, Name: "Sprint 1, the beginning", State: "closed"},
+			{ID: 8, Name: "Sprint 2", State: "active"},
+		}, sprints)
+	})
+
+	t.Run("Unparseable entries are skipped", func(t *testing.T) {
+		raw := decodeRaw(t, `["not a sprint", 42, {"id":1,"name":"S","state":"FUTURE"}]`)
+
+		sprints := jira.ParseSprints(raw)
+
+		assert.Equal(t, []jira.SprintRef{{ID: 1, Name: "S", State: "future"}}, sprints)
+	})
+
+	t.Run("Null field", func(t *testing.T) {
+		assert.Nil(t, jira.ParseSprints(nil))
+	})
+}
+
+func TestClient_GetIssue_Sprints(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"10001","key":"PROJ-1","self":"https://example.atlassian.net/rest/api/3/issue/10001","fields":{
+			"summary":"Sprinted",
+			"customfield_10020":[{"id":38,"name":"Sprint 13","state":"active"}]
+		}}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	issue, err := client.GetIssue(context.Background(), "PROJ-1", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []jira.SprintRef{{ID: 38, Name: "Sprint 13", State: "active"}}, issue.Sprints)
+}
@@ -0,0 +1,74 @@
+package jira
+
+import (
+	"context"
+	"strings"
+)
+
+// textareaSchemaCustom is the schema custom type of multi-line text custom fields.
+// API v3 only accepts their values in Atlassian Document Format.
+const textareaSchemaCustom = "com.atlassian.jira.plugin.system.customfieldtypes:textarea"
+
+// textareaFieldIDs returns the IDs of the multi-line text custom fields, looked up
+// with GET /rest/api/3/field and cached for createMetaCacheTTL.
+func (c *Client) textareaFieldIDs(ctx context.Context) (map[string]bool, error) {
+	c.textareaMu.Lock()
+	ids, expiresAt := c.textareaFields, c.textareaFieldsExpiry
+	c.textareaMu.Unlock()
+	if ids != nil && c.clock.Now().Before(expiresAt) {
+		return ids, nil
+	}
+
+	var fields []fieldDefinition
+	if err := c.doJSON(ctx, "GET", "/rest/api/3/field", nil, &fields); err != nil {
+		return nil, err
+	}
+	ids = make(map[string]bool)
+	for _, f := range fields {
+		if f.Schema.Custom == textareaSchemaCustom {
+			ids[f.ID] = true
+		}
+	}
+
+	c.textareaMu.Lock()
+	c.textareaFields, c.textareaFieldsExpiry = ids, c.clock.Now().Add(createMetaCacheTTL)
+	c.textareaMu.Unlock()
+	return ids, nil
+}
+
+// convertTextareaFields returns customFields with plain string values of multi-line
+// text custom fields converted to ADF. Field definitions are only fetched when a
+// custom field has a string value, and if they cannot be fetched the values are
+// sent as given.
+func (c *Client) convertTextareaFields(ctx context.Context, customFields map[string]interface{}) map[string]interface{} {
+	if c.apiVersion == 2 {
+		// API v2 takes multi-line text as plain strings
+		return customFields
+	}
+	hasText := false
+	for key, value := range customFields {
+		if _, ok := value.(string); ok && strings.HasPrefix(key, "customfield_") {
+			hasText = true
+			break
+		}
+	}
+	if !hasText {
+		return customFields
+	}
+
+	ids, err := c.textareaFieldIDs(ctx)
+	if err != nil {
+		c.logger.WarnContext(ctx, "Failed to look up multi-line text fields; sending custom fields as given", "error", err)
+		return customFields
+	}
+
+	converted := make(map[string]interface{}, len(customFields))
+	for key, value := range customFields {
+		if text, ok := value.(string); ok && ids[key] {
+			converted[key] = textToADF(text)
+		} else {
+			converted[key] = value
+		}
+	}
+	return converted
+}
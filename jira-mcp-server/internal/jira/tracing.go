@@ -0,0 +1,73 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the client's spans.
+const tracerName = "jira-mcp-server/internal/jira"
+
+// issuePathPrefix is the path prefix of the REST resources of a single issue.
+const issuePathPrefix = "/rest/api/3/issue/"
+
+// startCallSpan starts a client span for a call to JIRA, including its retries,
+// and injects the span's trace context into httpReq's headers so that JIRA's side
+// of the call can be joined to the trace.
+func (c *Client) startCallSpan(ctx context.Context, httpReq *http.Request) (context.Context, trace.Span) {
+	path, issueKey := tracedPath(c.restPath(httpReq.URL.Path))
+	attrs := []attribute.KeyValue{
+		attribute.String("jira.operation", callOperation(httpReq.Method, c.restPath(httpReq.URL.Path))),
+		attribute.String("http.request.method", httpReq.Method),
+		attribute.String("server.address", httpReq.URL.Host),
+		attribute.String("url.path", httpReq.URL.Path),
+	}
+	if issueKey != "" {
+		attrs = append(attrs, attribute.String("jira.issue_key", issueKey))
+	}
+
+	ctx, span := c.tracer.Start(ctx, "JIRA "+httpReq.Method+" "+path, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(httpReq.Header))
+	return ctx, span
+}
+
+// endCallSpan records the outcome of a call to JIRA on its span and ends it.
+func endCallSpan(span trace.Span, resp *http.Response, err error) {
+	defer span.End()
+	span.SetAttributes(attribute.String("jira.status_class", statusClass(resp, err)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+}
+
+// tracedPath returns path with the issue key or ID of single-issue resources replaced
+// by {issueKey}, keeping span names low in cardinality, and the issue key itself.
+func tracedPath(path string) (templated, issueKey string) {
+	rest, ok := strings.CutPrefix(path, issuePathPrefix)
+	if !ok || rest == "" {
+		return path, ""
+	}
+	key, sub, _ := strings.Cut(rest, "/")
+	if unescaped, err := url.PathUnescape(key); err == nil {
+		key = unescaped
+	}
+	templated = issuePathPrefix + "{issueKey}"
+	if sub != "" {
+		templated += "/" + sub
+	}
+	return templated, key
+}
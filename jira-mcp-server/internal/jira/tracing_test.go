@@ -0,0 +1,89 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"jira-mcp-server/internal/jira"
+)
+
+// spanAttributes returns a span's attributes as a map.
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestClient_TracesJiraCalls(t *testing.T) {
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(previous)
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("Traceparent")
+		if r.URL.Path == "/rest/api/3/issue/NOPE-1" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["Issue does not exist"]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"transitions":[]}`))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	client, err := jira.NewClient(jira.Config{
+		BaseURL:        server.URL,
+		UserEmail:      "test@example.com",
+		APIToken:       "test-token",
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+	}, server.Client())
+	require.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		_, err := client.GetTransitions(context.Background(), "PROJ-1")
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, "JIRA GET /rest/api/3/issue/{issueKey}/transitions", span.Name())
+		assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+		attrs := spanAttributes(span)
+		assert.Equal(t, "PROJ-1", attrs["jira.issue_key"].AsString())
+		assert.Equal(t, "other", attrs["jira.operation"].AsString())
+		assert.Equal(t, int64(http.StatusOK), attrs["http.response.status_code"].AsInt64())
+		assert.Equal(t, codes.Unset, span.Status().Code)
+
+		// The span's trace context is sent to JIRA
+		assert.Contains(t, traceparent, span.SpanContext().TraceID().String())
+		assert.Contains(t, traceparent, span.SpanContext().SpanID().String())
+	})
+
+	t.Run("Error Status", func(t *testing.T) {
+		_, err := client.GetIssue(context.Background(), "NOPE-1", nil)
+		require.Error(t, err)
+
+		spans := recorder.Ended()
+		span := spans[len(spans)-1]
+		assert.Equal(t, "JIRA GET /rest/api/3/issue/{issueKey}", span.Name())
+		attrs := spanAttributes(span)
+		assert.Equal(t, "get", attrs["jira.operation"].AsString())
+		assert.Equal(t, "4xx", attrs["jira.status_class"].AsString())
+		assert.Equal(t, codes.Error, span.Status().Code)
+	})
+}
@@ -0,0 +1,108 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Status category keys as reported by JIRA in StatusCategory.Key.
+const (
+	StatusCategoryToDo       = "new"
+	StatusCategoryInProgress = "indeterminate"
+	StatusCategoryDone       = "done"
+)
+
+// StatusCategory groups workflow statuses into to-do, in-progress and done.
+type StatusCategory struct {
+	ID   int    `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// Status represents a workflow status.
+type Status struct {
+	ID             string         `json:"id"`
+	Name           string         `json:"name"`
+	StatusCategory StatusCategory `json:"statusCategory"`
+}
+
+// Transition represents a workflow transition available on an issue and the status it leads to.
+type Transition struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	To   Status `json:"to"`
+}
+
+// GetTransitions returns the workflow transitions currently available on an issue,
+// using GET /rest/api/3/issue/{issueKey}/transitions.
+func (c *Client) GetTransitions(ctx context.Context, issueKey string) ([]Transition, error) {
+	if issueKey == "" {
+		return nil, fmt.Errorf("issue key cannot be empty")
+	}
+
+	var resp struct {
+		Transitions []Transition `json:"transitions"`
+	}
+	path := fmt.Sprintf("/rest/api/3/issue/%s/transitions", url.PathEscape(issueKey))
+	if err := c.doJSON(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Transitions, nil
+}
+
+// TransitionNotFoundError is returned by TransitionIssueByName when no transition
+// available on the issue has the requested name. Available lists the transitions that do.
+type TransitionNotFoundError struct {
+	IssueKey  string
+	Name      string
+	Available []Transition
+}
+
+func (e *TransitionNotFoundError) Error() string {
+	return fmt.Sprintf("transition %q is not available on issue %s (available: %s)", e.Name, e.IssueKey, strings.Join(e.AvailableNames(), ", "))
+}
+
+// AvailableNames returns the names of the transitions available on the issue.
+func (e *TransitionNotFoundError) AvailableNames() []string {
+	names := make([]string, 0, len(e.Available))
+	for _, t := range e.Available {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// TransitionIssue moves an issue through the workflow transition with the given ID,
+// using POST /rest/api/3/issue/{issueKey}/transitions.
+func (c *Client) TransitionIssue(ctx context.Context, issueKey, transitionID string) error {
+	if issueKey == "" || transitionID == "" {
+		return fmt.Errorf("issue key and transition ID are required")
+	}
+	defer c.issueCache.invalidate(issueKey)
+
+	payload := map[string]interface{}{"transition": map[string]string{"id": transitionID}}
+	path := fmt.Sprintf("/rest/api/3/issue/%s/transitions", url.PathEscape(issueKey))
+	return c.doJSON(ctx, "POST", path, payload, nil)
+}
+
+// TransitionIssueByName applies the transition named transitionName (e.g. "Done"),
+// matched case-insensitively against the transitions currently available on the
+// issue. Transition IDs differ between workflows, so this saves callers a lookup.
+// If no transition matches, a *TransitionNotFoundError is returned.
+func (c *Client) TransitionIssueByName(ctx context.Context, issueKey, transitionName string) error {
+	if transitionName == "" {
+		return fmt.Errorf("transition name cannot be empty")
+	}
+
+	transitions, err := c.GetTransitions(ctx, issueKey)
+	if err != nil {
+		return err
+	}
+	for _, t := range transitions {
+		if strings.EqualFold(strings.TrimSpace(t.Name), strings.TrimSpace(transitionName)) {
+			return c.TransitionIssue(ctx, issueKey, t.ID)
+		}
+	}
+	return &TransitionNotFoundError{IssueKey: issueKey, Name: transitionName, Available: transitions}
+}
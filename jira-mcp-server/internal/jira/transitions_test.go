@@ -0,0 +1,120 @@
+package jira_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_GetTransitions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "/rest/api/3/issue/PROJ-1/transitions", r.URL.Path)
+			assert.NotEmpty(t, r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"transitions":[
+				{"id":"21","name":"Start Progress","to":{"id":"3","name":"In Progress","statusCategory":{"id":4,"key":"indeterminate","name":"In Progress"}}},
+				{"id":"31","name":"Done","to":{"id":"10001","name":"Done","statusCategory":{"id":3,"key":"done","name":"Done"}}}
+			]}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		transitions, err := client.GetTransitions(ctx, "PROJ-1")
+
+		require.NoError(t, err)
+		require.Len(t, transitions, 2)
+		assert.Equal(t, "31", transitions[1].ID)
+		assert.Equal(t, jira.StatusCategoryDone, transitions[1].To.StatusCategory.Key)
+	})
+
+	t.Run("Error 404 Not Found", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		transitions, err := client.GetTransitions(ctx, "NOPE-1")
+
+		require.Error(t, err)
+		assert.Nil(t, transitions)
+		var jiraErr *jira.JiraAPIError
+		require.ErrorAs(t, err, &jiraErr)
+		assert.Equal(t, http.StatusNotFound, jiraErr.StatusCode)
+	})
+}
+
+func TestClient_TransitionIssue(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/transitions", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"transition":{"id":"31"}}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	require.NoError(t, client.TransitionIssue(context.Background(), "PROJ-1", "31"))
+}
+
+func TestClient_TransitionIssueByName(t *testing.T) {
+	ctx := context.Background()
+	transitionsJSON := `{"transitions":[{"id":"21","name":"Start Progress"},{"id":"31","name":"Done"}]}`
+
+	t.Run("Matches Case-Insensitively", func(t *testing.T) {
+		var applied string
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "GET" {
+				_, _ = w.Write([]byte(transitionsJSON))
+				return
+			}
+			var payload struct {
+				Transition struct {
+					ID string `json:"id"`
+				} `json:"transition"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			applied = payload.Transition.ID
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		require.NoError(t, client.TransitionIssueByName(ctx, "PROJ-1", "start progress"))
+		assert.Equal(t, "21", applied)
+	})
+
+	t.Run("No Match Lists Available Names", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method, "No transition should be applied")
+			_, _ = w.Write([]byte(transitionsJSON))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.TransitionIssueByName(ctx, "PROJ-1", "Closed")
+
+		var notFound *jira.TransitionNotFoundError
+		require.ErrorAs(t, err, &notFound)
+		assert.Equal(t, []string{"Start Progress", "Done"}, notFound.AvailableNames())
+		assert.Contains(t, err.Error(), `transition "Closed" is not available on issue PROJ-1 (available: Start Progress, Done)`)
+	})
+}
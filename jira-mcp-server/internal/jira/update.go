@@ -0,0 +1,82 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// UpdateIssueRequest defines the fields to change on an existing issue. Only
+// non-nil fields are sent, so fields left out of the request keep their values.
+// An empty Description or Environment clears that field.
+type UpdateIssueRequest struct {
+	Summary     *string `json:"summary,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Environment *string `json:"environment,omitempty"`
+	IssueType   *string `json:"issue_type,omitempty"`
+
+	// DescriptionFormat is the format of Description, as in CreateIssueRequest.
+	DescriptionFormat string `json:"description_format,omitempty"`
+}
+
+// IsEmpty reports whether the request changes no fields.
+func (r UpdateIssueRequest) IsEmpty() bool {
+	return r.Summary == nil && r.Description == nil && r.Environment == nil && r.IssueType == nil
+}
+
+// UpdateIssue edits an issue using PUT /rest/api/3/issue/{issueKey}. The fields
+// payload is built the same way as in CreateIssue; an issue type name is resolved
+// in the project named by the issue key, or sent as given for a numeric issue ID.
+func (c *Client) UpdateIssue(ctx context.Context, issueKey string, req UpdateIssueRequest) error {
+	if issueKey == "" {
+		return fmt.Errorf("issue key cannot be empty")
+	}
+	defer c.issueCache.invalidate(issueKey)
+
+	if req.IsEmpty() {
+		return fmt.Errorf("at least one field to update is required")
+	}
+	if req.Summary != nil && *req.Summary == "" {
+		return fmt.Errorf("summary cannot be empty")
+	}
+	if req.IssueType != nil && *req.IssueType == "" {
+		return fmt.Errorf("issue_type cannot be empty")
+	}
+	if !IsTextFormat(req.DescriptionFormat) {
+		return fmt.Errorf("unsupported description format %q", req.DescriptionFormat)
+	}
+
+	fields := map[string]interface{}{}
+	if req.Summary != nil {
+		fields["summary"] = *req.Summary
+	}
+	if req.Description != nil {
+		fields["description"] = c.richTextField(*req.Description, req.DescriptionFormat)
+	}
+	if req.Environment != nil {
+		fields["environment"] = c.richTextField(*req.Environment, TextFormatPlain)
+	}
+	if req.IssueType != nil {
+		issueType := issueTypeRef(*req.IssueType)
+		if dash := strings.LastIndex(issueKey, "-"); dash > 0 {
+			var err error
+			if issueType, err = c.resolveIssueTypeRef(ctx, issueKey[:dash], *req.IssueType); err != nil {
+				return err
+			}
+		}
+		fields["issuetype"] = issueType
+	}
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s", url.PathEscape(issueKey))
+	return c.doJSON(ctx, "PUT", path, map[string]interface{}{"fields": fields}, nil)
+}
+
+// richTextField converts text in the given format to the value of a rich text field
+// (see richText), or to null to clear it.
+func (c *Client) richTextField(text, format string) interface{} {
+	if text == "" {
+		return nil
+	}
+	return c.richText(text, format)
+}
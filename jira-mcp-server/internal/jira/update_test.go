@@ -0,0 +1,129 @@
+package jira_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestClient_UpdateIssue(t *testing.T) {
+	t.Run("Sends Only Present Fields", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "PUT", r.Method)
+			assert.Equal(t, "/rest/api/3/issue/PROJ-1", r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"fields":{
+				"summary":"New summary",
+				"description":{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"New description"}]}]}
+			}}`, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.UpdateIssue(context.Background(), "PROJ-1", jira.UpdateIssueRequest{
+			Summary:     strPtr("New summary"),
+			Description: strPtr("New description"),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Markdown Description", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"fields":{
+				"description":{"type":"doc","version":1,"content":[{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Steps"}]}]}
+			}}`, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.UpdateIssue(context.Background(), "PROJ-1", jira.UpdateIssueRequest{
+			Description:       strPtr("## Steps"),
+			DescriptionFormat: jira.TextFormatMarkdown,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Empty Description Clears It", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"fields":{"description":null,"issuetype":{"id":"10004"}}}`, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, withIssueTypes(handler))
+		defer server.Close()
+
+		err := client.UpdateIssue(context.Background(), "PROJ-1", jira.UpdateIssueRequest{
+			Description: strPtr(""),
+			IssueType:   strPtr("Bug"),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Localized Issue Type Name Resolved In The Issue's Project", func(t *testing.T) {
+		var lookups []string
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				lookups = append(lookups, r.URL.Path)
+				_, _ = w.Write([]byte(`{"startAt":0,"total":1,"issueTypes":[{"id":"10004","name":"Fehler"}]}`))
+				return
+			}
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"fields":{"issuetype":{"id":"10004"}}}`, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.UpdateIssue(context.Background(), "PROJ-1", jira.UpdateIssueRequest{IssueType: strPtr("fehler")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/rest/api/3/issue/createmeta/PROJ/issuetypes"}, lookups)
+
+		err = client.UpdateIssue(context.Background(), "PROJ-1", jira.UpdateIssueRequest{IssueType: strPtr("Epic")})
+		var notFound *jira.IssueTypeNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("Error Not Found", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.UpdateIssue(context.Background(), "PROJ-404", jira.UpdateIssueRequest{Summary: strPtr("x")})
+
+		var apiErr *jira.JiraAPIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	})
+
+	t.Run("Error No Fields", func(t *testing.T) {
+		client, err := jira.NewClient(dummyConfig, nil)
+		require.NoError(t, err)
+
+		err = client.UpdateIssue(context.Background(), "PROJ-1", jira.UpdateIssueRequest{})
+		assert.EqualError(t, err, "at least one field to update is required")
+	})
+}
@@ -0,0 +1,151 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// userCacheTTL is how long GetUser results are reused. Display names change rarely.
+const userCacheTTL = 10 * time.Minute
+
+// userCacheSize is the number of GetUser results kept, like DefaultIssueCacheSize.
+const userCacheSize = 500
+
+// userCacheEntry is a cached GetUser result.
+type userCacheEntry struct {
+	user      *User
+	expiresAt time.Time
+}
+
+// AmbiguousUserError is returned when an email address matches more than one JIRA
+// user and the server cannot safely pick one. Candidates lists the matching users.
+type AmbiguousUserError struct {
+	Email      string
+	Candidates []User
+}
+
+func (e *AmbiguousUserError) Error() string {
+	return fmt.Sprintf("%d JIRA users match email %q", len(e.Candidates), e.Email)
+}
+
+// UserNotFoundError is returned when no JIRA user matches an email address.
+type UserNotFoundError struct {
+	Email string
+}
+
+func (e *UserNotFoundError) Error() string {
+	return fmt.Sprintf("no JIRA user found for email %s", e.Email)
+}
+
+// SearchUsers returns the users matching query (a display name or email address
+// prefix) using GET /rest/api/3/user/search.
+func (c *Client) SearchUsers(ctx context.Context, query string) ([]User, error) {
+	if query == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+
+	var users []User
+	if err := c.doJSON(ctx, "GET", "/rest/api/3/user/search?"+url.Values{"query": {query}}.Encode(), nil, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// ResolveAccountID returns the accountId of the user with the given email address.
+// User search matches prefixes of names and addresses, so a user whose visible email
+// matches exactly is preferred; otherwise the search must yield exactly one user.
+// Multiple matches are reported as an *AmbiguousUserError rather than guessed at.
+func (c *Client) ResolveAccountID(ctx context.Context, email string) (string, error) {
+	users, err := c.SearchUsers(ctx, email)
+	if err != nil {
+		return "", err
+	}
+
+	var exact []User
+	for _, u := range users {
+		if strings.EqualFold(u.EmailAddress, email) {
+			exact = append(exact, u)
+		}
+	}
+
+	switch {
+	case len(exact) == 1:
+		return exact[0].AccountID, nil
+	case len(exact) > 1:
+		return "", &AmbiguousUserError{Email: email, Candidates: exact}
+	case len(users) == 1:
+		// The address may be hidden by the user's privacy settings
+		return users[0].AccountID, nil
+	case len(users) == 0:
+		return "", &UserNotFoundError{Email: email}
+	default:
+		return "", &AmbiguousUserError{Email: email, Candidates: users}
+	}
+}
+
+// GetUser returns the user with the given accountId using GET /rest/api/3/user.
+// Results are cached for userCacheTTL, up to userCacheSize users.
+func (c *Client) GetUser(ctx context.Context, accountID string) (*User, error) {
+	if accountID == "" {
+		return nil, fmt.Errorf("account ID cannot be empty")
+	}
+
+	c.userMu.Lock()
+	entry, ok := c.userCache[accountID]
+	if ok && !c.clock.Now().Before(entry.expiresAt) {
+		delete(c.userCache, accountID)
+		ok = false
+	}
+	c.userMu.Unlock()
+	if ok {
+		return entry.user, nil
+	}
+
+	var user User
+	if err := c.doJSON(ctx, "GET", "/rest/api/3/user?"+url.Values{"accountId": {accountID}}.Encode(), nil, &user); err != nil {
+		return nil, err
+	}
+
+	c.userMu.Lock()
+	if c.userCache == nil {
+		c.userCache = make(map[string]userCacheEntry)
+	}
+	if _, ok := c.userCache[accountID]; !ok && len(c.userCache) >= userCacheSize {
+		c.evictUser()
+	}
+	c.userCache[accountID] = userCacheEntry{user: &user, expiresAt: c.clock.Now().Add(userCacheTTL)}
+	c.userMu.Unlock()
+	return &user, nil
+}
+
+// evictUser makes room in the user cache by dropping the expired entries or, if
+// none have expired, the one expiring first. c.userMu must be held.
+func (c *Client) evictUser() {
+	now := c.clock.Now()
+	var oldestID string
+	var oldest time.Time
+	for id, entry := range c.userCache {
+		if !now.Before(entry.expiresAt) {
+			delete(c.userCache, id)
+		} else if oldestID == "" || entry.expiresAt.Before(oldest) {
+			oldestID, oldest = id, entry.expiresAt
+		}
+	}
+	if len(c.userCache) >= userCacheSize {
+		delete(c.userCache, oldestID)
+	}
+}
+
+// GetCurrentUser returns the user the client authenticates as, using
+// GET /rest/api/3/myself. The email address is only present if the user's
+// profile visibility allows it.
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.doJSON(ctx, "GET", "/rest/api/3/myself", nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
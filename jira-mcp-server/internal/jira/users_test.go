@@ -0,0 +1,167 @@
+package jira_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/clock"
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_SearchUsers(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/3/user/search", r.URL.Path)
+		assert.Equal(t, "jane@example.com", r.URL.Query().Get("query"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"accountId":"abc-1","displayName":"Jane Doe","emailAddress":"jane@example.com","active":true}]`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	users, err := client.SearchUsers(context.Background(), "jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []jira.User{{AccountID: "abc-1", DisplayName: "Jane Doe", EmailAddress: "jane@example.com", Active: true}}, users)
+}
+
+func TestClient_ResolveAccountID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Exact Email Match Preferred", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[
+				{"accountId":"abc-1","displayName":"Jane Doe","emailAddress":"jane@example.com"},
+				{"accountId":"abc-2","displayName":"Jane Doe-Smith","emailAddress":"jane@example.com.au"}
+			]`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		accountID, err := client.ResolveAccountID(ctx, "jane@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "abc-1", accountID)
+	})
+
+	t.Run("Ambiguous Match", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[
+				{"accountId":"abc-1","displayName":"Jane Doe"},
+				{"accountId":"abc-2","displayName":"Jane Doe"}
+			]`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		_, err := client.ResolveAccountID(ctx, "jane@example.com")
+		var ambiguous *jira.AmbiguousUserError
+		require.True(t, errors.As(err, &ambiguous), "expected AmbiguousUserError, got %v", err)
+		assert.Equal(t, "jane@example.com", ambiguous.Email)
+		require.Len(t, ambiguous.Candidates, 2)
+		assert.Equal(t, "abc-1", ambiguous.Candidates[0].AccountID)
+		assert.Equal(t, "abc-2", ambiguous.Candidates[1].AccountID)
+	})
+
+	t.Run("No Match", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[]`))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		_, err := client.ResolveAccountID(ctx, "jane@example.com")
+		var notFound *jira.UserNotFoundError
+		require.True(t, errors.As(err, &notFound), "expected UserNotFoundError, got %v", err)
+		assert.EqualError(t, err, "no JIRA user found for email jane@example.com")
+	})
+}
+
+func TestClient_GetUser_Cached(t *testing.T) {
+	var calls int
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/rest/api/3/user", r.URL.Path)
+		assert.Equal(t, "abc-1", r.URL.Query().Get("accountId"))
+		_, _ = w.Write([]byte(`{"accountId":"abc-1","displayName":"Jane Doe","active":true}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		user, err := client.GetUser(context.Background(), "abc-1")
+		require.NoError(t, err)
+		assert.Equal(t, "Jane Doe", user.DisplayName)
+	}
+	assert.Equal(t, 1, calls, "The second lookup should be served from the cache")
+}
+
+func TestClient_GetUser_CacheBounded(t *testing.T) {
+	ctx := context.Background()
+	calls := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accountID := r.URL.Query().Get("accountId")
+		calls[accountID]++
+		_, _ = fmt.Fprintf(w, `{"accountId":%q,"displayName":"User","active":true}`, accountID)
+	}))
+	defer server.Close()
+
+	fakeClock := clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	cfg := dummyConfig
+	cfg.BaseURL = server.URL
+	cfg.Clock = fakeClock
+	client, err := jira.NewClient(cfg, server.Client())
+	require.NoError(t, err)
+
+	t.Run("Expired Entry Fetched Again", func(t *testing.T) {
+		_, err := client.GetUser(ctx, "abc-1")
+		require.NoError(t, err)
+		fakeClock.Advance(10 * time.Minute)
+		_, err = client.GetUser(ctx, "abc-1")
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls["abc-1"])
+	})
+
+	t.Run("Oldest Entry Evicted When Full", func(t *testing.T) {
+		// abc-1 was cached last, then 500 more users fill the cache
+		for i := 0; i < 500; i++ {
+			fakeClock.Advance(time.Millisecond)
+			_, err := client.GetUser(ctx, fmt.Sprintf("user-%d", i))
+			require.NoError(t, err)
+		}
+		_, err := client.GetUser(ctx, "user-499")
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls["user-499"], "Recent users should still be cached")
+
+		_, err = client.GetUser(ctx, "abc-1")
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls["abc-1"], "The oldest user should have been evicted")
+	})
+}
+
+func TestClient_GetCurrentUser(t *testing.T) {
+	server, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/3/myself", r.URL.Path)
+		_, _ = w.Write([]byte(`{"accountId":"abc-123","displayName":"JIRA Bot","emailAddress":"bot@example.com","active":true,"timeZone":"UTC"}`))
+	})
+	defer server.Close()
+
+	user, err := client.GetCurrentUser(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &jira.User{AccountID: "abc-123", DisplayName: "JIRA Bot", EmailAddress: "bot@example.com", Active: true}, user)
+}
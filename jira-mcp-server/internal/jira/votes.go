@@ -0,0 +1,27 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Votes summarizes the votes on an issue.
+type Votes struct {
+	Votes    int  `json:"votes"`
+	HasVoted bool `json:"hasVoted"` // Whether the authenticated user has voted
+}
+
+// GetVotes returns the vote count of an issue using GET /rest/api/3/issue/{issueKey}/votes.
+func (c *Client) GetVotes(ctx context.Context, issueKey string) (*Votes, error) {
+	if issueKey == "" {
+		return nil, fmt.Errorf("issue key cannot be empty")
+	}
+
+	var votes Votes
+	path := fmt.Sprintf("/rest/api/3/issue/%s/votes", url.PathEscape(issueKey))
+	if err := c.doJSON(ctx, "GET", path, nil, &votes); err != nil {
+		return nil, err
+	}
+	return &votes, nil
+}
@@ -0,0 +1,27 @@
+package jira_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetVotes(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/votes", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"self":"http://fakejira.com/rest/api/3/issue/PROJ-1/votes","votes":7,"hasVoted":true,"voters":[]}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	votes, err := client.GetVotes(context.Background(), "PROJ-1")
+	require.NoError(t, err)
+	assert.Equal(t, 7, votes.Votes)
+	assert.True(t, votes.HasVoted)
+}
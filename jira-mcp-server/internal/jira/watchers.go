@@ -0,0 +1,53 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Watchers lists the users watching an issue.
+type Watchers struct {
+	WatchCount int    `json:"watchCount"`
+	IsWatching bool   `json:"isWatching"` // Whether the authenticated user is watching
+	Watchers   []User `json:"watchers"`
+}
+
+// GetWatchers returns the watchers of an issue using GET /rest/api/3/issue/{issueKey}/watchers.
+func (c *Client) GetWatchers(ctx context.Context, issueKey string) (*Watchers, error) {
+	if issueKey == "" {
+		return nil, fmt.Errorf("issue key cannot be empty")
+	}
+
+	var watchers Watchers
+	path := fmt.Sprintf("/rest/api/3/issue/%s/watchers", url.PathEscape(issueKey))
+	if err := c.doJSON(ctx, "GET", path, nil, &watchers); err != nil {
+		return nil, err
+	}
+	return &watchers, nil
+}
+
+// AddWatcher makes the user with the given accountId watch an issue, using
+// POST /rest/api/3/issue/{issueKey}/watchers. JIRA expects the body to be the
+// accountId as a bare JSON string rather than an object.
+func (c *Client) AddWatcher(ctx context.Context, issueKey, accountID string) error {
+	if issueKey == "" || accountID == "" {
+		return fmt.Errorf("issue key and account ID are required")
+	}
+	defer c.issueCache.invalidate(issueKey)
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s/watchers", url.PathEscape(issueKey))
+	return c.doJSON(ctx, "POST", path, accountID, nil)
+}
+
+// RemoveWatcher stops the user with the given accountId watching an issue, using
+// DELETE /rest/api/3/issue/{issueKey}/watchers?accountId=...
+func (c *Client) RemoveWatcher(ctx context.Context, issueKey, accountID string) error {
+	if issueKey == "" || accountID == "" {
+		return fmt.Errorf("issue key and account ID are required")
+	}
+	defer c.issueCache.invalidate(issueKey)
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s/watchers?%s", url.PathEscape(issueKey), url.Values{"accountId": {accountID}}.Encode())
+	return c.doJSON(ctx, "DELETE", path, nil, nil)
+}
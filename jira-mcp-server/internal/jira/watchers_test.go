@@ -0,0 +1,66 @@
+package jira_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/jira"
+)
+
+func TestClient_GetWatchers(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/watchers", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"self":"http://fakejira.com/rest/api/3/issue/PROJ-1/watchers","isWatching":true,"watchCount":1,
+			"watchers":[{"accountId":"abc-1","displayName":"Jane Doe","active":true}]}`))
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	watchers, err := client.GetWatchers(context.Background(), "PROJ-1")
+	require.NoError(t, err)
+	assert.Equal(t, &jira.Watchers{
+		WatchCount: 1,
+		IsWatching: true,
+		Watchers:   []jira.User{{AccountID: "abc-1", DisplayName: "Jane Doe", Active: true}},
+	}, watchers)
+}
+
+func TestClient_AddWatcher(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/watchers", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		// The body is the accountId as a bare JSON string, not {"accountId": ...}
+		assert.Equal(t, `"abc-1"`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	require.NoError(t, client.AddWatcher(context.Background(), "PROJ-1", "abc-1"))
+}
+
+func TestClient_RemoveWatcher(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/watchers", r.URL.Path)
+		assert.Equal(t, "abc:1", r.URL.Query().Get("accountId"))
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	require.NoError(t, client.RemoveWatcher(context.Background(), "PROJ-1", "abc:1"))
+}
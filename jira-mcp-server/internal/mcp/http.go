@@ -0,0 +1,43 @@
+package mcp
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// toolDescription describes a tool for GET /tools, including the REST endpoint
+// that performs it.
+type toolDescription struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Method      string          `json:"method"`
+	Path        string          `json:"path"`
+	InputSchema json.RawMessage `json:"inputSchema"`
+}
+
+// ToolsHandler handles GET requests to /tools. It lists the tools offered over MCP
+// with their REST method and path and the JSON Schema of their input, giving HTTP
+// clients the same discovery as tools/list.
+func ToolsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		_, _ = w.Write([]byte(`{"error":"Method not allowed"}`))
+		return
+	}
+
+	tools := DefaultTools()
+	descriptions := make([]toolDescription, 0, len(tools))
+	for _, tool := range tools {
+		descriptions = append(descriptions, toolDescription{
+			Name:        tool.Name,
+			Description: tool.Description,
+			Method:      tool.Method,
+			Path:        tool.Path,
+			InputSchema: tool.InputSchema,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"tools": descriptions})
+}
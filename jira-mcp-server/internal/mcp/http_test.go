@@ -0,0 +1,41 @@
+package mcp_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/mcp"
+)
+
+func TestToolsHandler(t *testing.T) {
+	rr := httptest.NewRecorder()
+	mcp.ToolsHandler(rr, httptest.NewRequest(http.MethodGet, "/tools", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var body struct {
+		Tools []struct {
+			Name        string                 `json:"name"`
+			Method      string                 `json:"method"`
+			Path        string                 `json:"path"`
+			InputSchema map[string]interface{} `json:"inputSchema"`
+		} `json:"tools"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	require.Len(t, body.Tools, 4)
+
+	create := body.Tools[0]
+	assert.Equal(t, "create_jira_issue", create.Name)
+	assert.Equal(t, "POST", create.Method)
+	assert.Equal(t, "/create_jira_issue", create.Path)
+	assert.Equal(t, []interface{}{"project_key", "summary", "issue_type"}, create.InputSchema["required"])
+	assert.Contains(t, create.InputSchema["properties"], "epic_key")
+
+	get := body.Tools[2]
+	assert.Equal(t, "GET", get.Method)
+	assert.Equal(t, "/jira_issue/{issueKey}", get.Path)
+}
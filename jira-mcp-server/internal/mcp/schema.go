@@ -0,0 +1,82 @@
+package mcp
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// SchemaFor derives a JSON Schema describing the JSON encoding of v's type, so that
+// tool schemas follow the request structs they are decoded into. Fields are named
+// by their json tags; a `jsonschema:"required"` tag marks a field as required and a
+// `description:"..."` tag documents it.
+func SchemaFor(v interface{}) json.RawMessage {
+	schema, err := json.Marshal(schemaOf(reflect.TypeOf(v)))
+	if err != nil {
+		// schemaOf only produces maps, strings and slices of strings
+		panic(err)
+	}
+	return schema
+}
+
+// schemaOf returns the schema of t as a JSON-encodable map.
+func schemaOf(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": schemaOf(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object"}
+	case reflect.Struct:
+		properties := map[string]interface{}{}
+		var required []string
+		addStructFields(t, properties, &required)
+		schema := map[string]interface{}{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema
+	default:
+		// interface{} and anything else accepts any JSON value
+		return map[string]interface{}{}
+	}
+}
+
+// addStructFields adds the JSON fields of struct type t to properties, flattening
+// embedded structs the way encoding/json does.
+func addStructFields(t reflect.Type, properties map[string]interface{}, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addStructFields(field.Type, properties, required)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		property := schemaOf(field.Type)
+		if description := field.Tag.Get("description"); description != "" {
+			property["description"] = description
+		}
+		properties[name] = property
+		if field.Tag.Get("jsonschema") == "required" {
+			*required = append(*required, name)
+		}
+	}
+}
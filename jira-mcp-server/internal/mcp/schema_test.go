@@ -0,0 +1,45 @@
+package mcp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"jira-mcp-server/internal/jira"
+	"jira-mcp-server/internal/mcp"
+)
+
+func TestSchemaFor(t *testing.T) {
+	type embedded struct {
+		Page int `json:"page"`
+	}
+	type args struct {
+		embedded
+		Key     string                 `json:"key" jsonschema:"required" description:"An issue key"`
+		Labels  []string               `json:"labels,omitempty"`
+		Ratio   float64                `json:"ratio"`
+		Flag    *bool                  `json:"flag"`
+		Extra   map[string]interface{} `json:"extra"`
+		Any     interface{}            `json:"any"`
+		Skipped string                 `json:"-"`
+		hidden  string
+	}
+
+	assert.JSONEq(t, `{"type":"object","required":["key"],"properties":{
+		"page":{"type":"integer"},
+		"key":{"type":"string","description":"An issue key"},
+		"labels":{"type":"array","items":{"type":"string"}},
+		"ratio":{"type":"number"},
+		"flag":{"type":"boolean"},
+		"extra":{"type":"object"},
+		"any":{}
+	}}`, string(mcp.SchemaFor(args{hidden: ""})))
+}
+
+func TestSchemaFor_CreateIssueRequest(t *testing.T) {
+	schema := string(mcp.SchemaFor(jira.CreateIssueRequest{}))
+
+	assert.Contains(t, schema, `"required":["project_key","summary","issue_type"]`)
+	assert.Contains(t, schema, `"custom_fields":{"type":"object"}`)
+	assert.Contains(t, schema, `"properties":{"items":{"properties":{"key":{"type":"string"},"value":{}},"type":"object"},"type":"array"}`)
+}
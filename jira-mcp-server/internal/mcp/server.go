@@ -0,0 +1,156 @@
+// Package mcp implements the Model Context Protocol's JSON-RPC 2.0 interface over
+// stdio, so that MCP clients can use the server's operations as tools.
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+// ProtocolVersion is the MCP protocol revision implemented by Server.
+const ProtocolVersion = "2024-11-05"
+
+// maxMessageSize bounds a single newline-delimited JSON-RPC message.
+const maxMessageSize = 10 << 20
+
+// JSON-RPC 2.0 error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// request is a JSON-RPC request or, without an ID, a notification.
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// response is a JSON-RPC response carrying either a result or an error.
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError is the error member of a JSON-RPC response.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Server answers MCP requests. Tool calls are dispatched as HTTP requests to handler,
+// normally the API router, so they go through the same validation, JIRA calls and
+// error mapping as the REST endpoints.
+type Server struct {
+	handler http.Handler
+	logger  *slog.Logger
+	tools   []Tool
+
+	// Version is reported as the server version during initialization.
+	Version string
+}
+
+// NewServer returns a Server exposing DefaultTools backed by handler.
+func NewServer(handler http.Handler, logger *slog.Logger) *Server {
+	return &Server{handler: handler, logger: logger, tools: DefaultTools(), Version: "dev"}
+}
+
+// Serve reads newline-delimited JSON-RPC messages from in and writes a response
+// line to out for every request; notifications get no response. It returns nil
+// when in reaches EOF, or ctx's error once ctx is done.
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+	encoder := json.NewEncoder(out)
+
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		resp := s.handleMessage(ctx, line)
+		if resp == nil {
+			continue
+		}
+		if err := encoder.Encode(resp); err != nil {
+			return fmt.Errorf("failed to write response: %w", err)
+		}
+	}
+	return scanner.Err()
+}
+
+// handleMessage handles one JSON-RPC message, returning nil for notifications.
+func (s *Server) handleMessage(ctx context.Context, line []byte) *response {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return errorResponse(json.RawMessage("null"), codeParseError, "Parse error")
+	}
+	if len(req.ID) == 0 {
+		// Notifications such as notifications/initialized need no answer
+		s.logger.DebugContext(ctx, "MCP notification received", "method", req.Method)
+		return nil
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return errorResponse(req.ID, codeInvalidRequest, "Invalid request")
+	}
+
+	s.logger.InfoContext(ctx, "MCP request received", "method", req.Method)
+	switch req.Method {
+	case "initialize":
+		return resultResponse(req.ID, map[string]interface{}{
+			"protocolVersion": ProtocolVersion,
+			"capabilities":    map[string]interface{}{"tools": map[string]interface{}{}},
+			"serverInfo":      map[string]string{"name": "jira-mcp-server", "version": s.Version},
+		})
+	case "ping":
+		return resultResponse(req.ID, map[string]interface{}{})
+	case "tools/list":
+		return resultResponse(req.ID, map[string]interface{}{"tools": s.tools})
+	case "tools/call":
+		var params struct {
+			Name      string          `json:"name"`
+			Arguments json.RawMessage `json:"arguments"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil || params.Name == "" {
+			return errorResponse(req.ID, codeInvalidParams, "Invalid params: expected a tool name and arguments")
+		}
+		tool := s.findTool(params.Name)
+		if tool == nil {
+			return errorResponse(req.ID, codeInvalidParams, fmt.Sprintf("Unknown tool %q", params.Name))
+		}
+		return resultResponse(req.ID, s.callTool(ctx, tool, params.Arguments))
+	default:
+		return errorResponse(req.ID, codeMethodNotFound, fmt.Sprintf("Method not found: %s", req.Method))
+	}
+}
+
+// findTool returns the tool with the given name, or nil.
+func (s *Server) findTool(name string) *Tool {
+	for i := range s.tools {
+		if s.tools[i].Name == name {
+			return &s.tools[i]
+		}
+	}
+	return nil
+}
+
+func resultResponse(id json.RawMessage, result interface{}) *response {
+	return &response{JSONRPC: "2.0", ID: id, Result: result}
+}
+
+func errorResponse(id json.RawMessage, code int, message string) *response {
+	return &response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: message}}
+}
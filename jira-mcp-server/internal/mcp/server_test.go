@@ -0,0 +1,174 @@
+package mcp_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/mcp"
+)
+
+// newTestRouter returns a router standing in for the API router: it echoes what
+// each route received, and rejects creates without a summary.
+func newTestRouter() *mux.Router {
+	r := mux.NewRouter()
+	r.HandleFunc("/create_jira_issue", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["summary"] == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"Missing required fields"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"key":"PROJ-1"}`))
+	}).Methods("POST")
+	r.HandleFunc("/jira_issue/{issueKey}", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"key": mux.Vars(r)["issueKey"], "fields": r.URL.Query().Get("fields")})
+	}).Methods("GET")
+	return r
+}
+
+// exchange sends lines to a new server and returns the decoded response lines.
+func exchange(t *testing.T, lines ...string) []map[string]interface{} {
+	t.Helper()
+	server := mcp.NewServer(newTestRouter(), slog.New(slog.NewJSONHandler(io.Discard, nil)))
+	var out bytes.Buffer
+	require.NoError(t, server.Serve(context.Background(), strings.NewReader(strings.Join(lines, "\n")+"\n"), &out))
+
+	var responses []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &resp))
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+func TestServer_Initialize(t *testing.T) {
+	responses := exchange(t,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"ping"}`,
+	)
+
+	require.Len(t, responses, 2, "The notification must not be answered")
+	result := responses[0]["result"].(map[string]interface{})
+	assert.Equal(t, mcp.ProtocolVersion, result["protocolVersion"])
+	assert.Contains(t, result["capabilities"], "tools")
+	assert.Equal(t, "jira-mcp-server", result["serverInfo"].(map[string]interface{})["name"])
+	assert.Equal(t, 2.0, responses[1]["id"])
+}
+
+func TestServer_ToolsList(t *testing.T) {
+	responses := exchange(t, `{"jsonrpc":"2.0","id":"list","method":"tools/list"}`)
+
+	require.Len(t, responses, 1)
+	assert.Equal(t, "list", responses[0]["id"])
+	tools := responses[0]["result"].(map[string]interface{})["tools"].([]interface{})
+	var names []string
+	for _, tool := range tools {
+		tool := tool.(map[string]interface{})
+		names = append(names, tool["name"].(string))
+		assert.Equal(t, "object", tool["inputSchema"].(map[string]interface{})["type"])
+	}
+	assert.Equal(t, []string{"create_jira_issue", "search_jira_issues", "get_issue", "get_epic_issues"}, names)
+}
+
+func TestServer_ToolsCall(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		responses := exchange(t, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"create_jira_issue","arguments":{"project_key":"PROJ","summary":"Broken","issue_type":"Bug"}}}`)
+
+		require.Len(t, responses, 1)
+		result := responses[0]["result"].(map[string]interface{})
+		assert.Equal(t, false, result["isError"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"type": "text", "text": `{"key":"PROJ-1"}`}}, result["content"])
+	})
+
+	t.Run("Path And Query Arguments", func(t *testing.T) {
+		responses := exchange(t, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"get_issue","arguments":{"issue_key":"PROJ-7","fields":["summary","status"]}}}`)
+
+		result := responses[0]["result"].(map[string]interface{})
+		text := result["content"].([]interface{})[0].(map[string]interface{})["text"].(string)
+		assert.JSONEq(t, `{"key":"PROJ-7","fields":"summary,status"}`, text)
+	})
+
+	t.Run("Operation Failure Reported As Tool Error", func(t *testing.T) {
+		responses := exchange(t, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"create_jira_issue","arguments":{"project_key":"PROJ"}}}`)
+
+		result := responses[0]["result"].(map[string]interface{})
+		assert.Equal(t, true, result["isError"])
+		assert.Nil(t, responses[0]["error"])
+	})
+
+	t.Run("Missing Required Argument", func(t *testing.T) {
+		responses := exchange(t, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"get_issue","arguments":{}}}`)
+
+		result := responses[0]["result"].(map[string]interface{})
+		assert.Equal(t, true, result["isError"])
+	})
+
+	t.Run("Unknown Tool", func(t *testing.T) {
+		responses := exchange(t, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"delete_everything","arguments":{}}}`)
+
+		assert.Equal(t, -32602.0, responses[0]["error"].(map[string]interface{})["code"])
+	})
+}
+
+func TestServer_Errors(t *testing.T) {
+	responses := exchange(t,
+		`not json`,
+		`{"jsonrpc":"2.0","id":2,"method":"resources/list"}`,
+		`{"jsonrpc":"1.0","id":3,"method":"ping"}`,
+	)
+
+	require.Len(t, responses, 3)
+	assert.Nil(t, responses[0]["id"])
+	assert.Equal(t, -32700.0, responses[0]["error"].(map[string]interface{})["code"])
+	assert.Equal(t, -32601.0, responses[1]["error"].(map[string]interface{})["code"])
+	assert.Equal(t, -32600.0, responses[2]["error"].(map[string]interface{})["code"])
+}
+
+// ctxKey marks the context passed to Serve in TestServer_LogsWithContext.
+type ctxKey struct{}
+
+// contextRecorder is a slog.Handler that records the ctxKey value of the context
+// each record was logged with.
+type contextRecorder struct {
+	slog.Handler
+	values []interface{}
+}
+
+func (h *contextRecorder) Handle(ctx context.Context, _ slog.Record) error {
+	h.values = append(h.values, ctx.Value(ctxKey{}))
+	return nil
+}
+
+func TestServer_LogsWithContext(t *testing.T) {
+	recorder := &contextRecorder{Handler: slog.NewJSONHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelDebug})}
+	server := mcp.NewServer(newTestRouter(), slog.New(recorder))
+	ctx := context.WithValue(context.Background(), ctxKey{}, "req-1")
+	lines := strings.Join([]string{
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"create_jira_issue","arguments":{"project_key":"PROJ"}}}`,
+	}, "\n") + "\n"
+
+	require.NoError(t, server.Serve(ctx, strings.NewReader(lines), io.Discard))
+
+	require.NotEmpty(t, recorder.values)
+	for _, value := range recorder.values {
+		assert.Equal(t, "req-1", value, "every log line should carry the request's context")
+	}
+}
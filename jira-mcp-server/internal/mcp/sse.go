@@ -0,0 +1,157 @@
+package mcp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultSSEKeepAlive is how often an idle SSE stream receives a comment line, so
+// that proxies do not drop the connection.
+const DefaultSSEKeepAlive = 15 * time.Second
+
+// sseQueueSize is the number of responses buffered per session while the stream
+// is busy writing.
+const sseQueueSize = 16
+
+// SSETransport serves MCP over Server-Sent Events: a client opens a stream with
+// GET /sse, is told where to POST its JSON-RPC messages, and receives the
+// responses as "message" events on the stream.
+type SSETransport struct {
+	server *Server
+
+	// KeepAlive is the interval between keep-alive comments; zero disables them.
+	KeepAlive time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*sseSession
+}
+
+// sseSession is an open stream. ctx is cancelled when the client disconnects,
+// which also cancels the tool calls it started.
+type sseSession struct {
+	ctx       context.Context
+	responses chan *response
+}
+
+// NewSSETransport returns an SSE transport dispatching to server.
+func NewSSETransport(server *Server) *SSETransport {
+	return &SSETransport{server: server, KeepAlive: DefaultSSEKeepAlive, sessions: map[string]*sseSession{}}
+}
+
+// Sessions returns the number of open streams.
+func (t *SSETransport) Sessions() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.sessions)
+}
+
+// StreamHandler handles GET requests to /sse. It first sends an "endpoint" event
+// with the URL to POST messages to, then a "message" event per response, until
+// the client disconnects.
+func (t *SSETransport) StreamHandler(w http.ResponseWriter, r *http.Request) {
+	controller := http.NewResponseController(w)
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	id := newSessionID()
+	session := &sseSession{ctx: ctx, responses: make(chan *response, sseQueueSize)}
+	t.mu.Lock()
+	t.sessions[id] = session
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		delete(t.sessions, id)
+		t.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	if err := writeEvent(w, "endpoint", "/messages?sessionId="+id); err != nil || controller.Flush() != nil {
+		return
+	}
+	t.server.logger.InfoContext(ctx, "MCP SSE session opened", "session", id)
+	defer t.server.logger.InfoContext(ctx, "MCP SSE session closed", "session", id)
+
+	var keepAlive <-chan time.Time
+	if t.KeepAlive > 0 {
+		ticker := time.NewTicker(t.KeepAlive)
+		defer ticker.Stop()
+		keepAlive = ticker.C
+	}
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case resp := <-session.responses:
+			var data []byte
+			if data, err = json.Marshal(resp); err == nil {
+				err = writeEvent(w, "message", string(data))
+			}
+		case <-keepAlive:
+			_, err = io.WriteString(w, ": keep-alive\n\n")
+		}
+		if err == nil {
+			err = controller.Flush()
+		}
+		if err != nil {
+			t.server.logger.WarnContext(ctx, "MCP SSE write failed; closing session", "session", id, "error", err)
+			return
+		}
+	}
+}
+
+// MessageHandler handles POST requests to /messages?sessionId=... carrying one
+// JSON-RPC message. It answers 202 Accepted at once; the response, if any, is sent
+// on the session's stream.
+func (t *SSETransport) MessageHandler(w http.ResponseWriter, r *http.Request) {
+	t.mu.Lock()
+	session := t.sessions[r.URL.Query().Get("sessionId")]
+	t.mu.Unlock()
+	if session == nil {
+		http.Error(w, "Unknown or closed session", http.StatusNotFound)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxMessageSize))
+	if err != nil || len(body) == 0 {
+		http.Error(w, "Invalid message body", http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+
+	go func() {
+		resp := t.server.handleMessage(session.ctx, body)
+		if resp == nil {
+			return
+		}
+		select {
+		case session.responses <- resp:
+		case <-session.ctx.Done():
+		}
+	}()
+}
+
+// writeEvent writes one SSE event. data must not contain newlines, which holds
+// for the session URL and for compact JSON.
+func writeEvent(w io.Writer, event, data string) error {
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
+
+// newSessionID returns a random 16-byte hex ID.
+func newSessionID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
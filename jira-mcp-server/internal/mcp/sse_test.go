@@ -0,0 +1,109 @@
+package mcp_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"jira-mcp-server/internal/mcp"
+)
+
+// sseEvent is one event or comment read from an SSE stream.
+type sseEvent struct {
+	event, data, comment string
+}
+
+// readEvent reads the next event or comment block from stream.
+func readEvent(t *testing.T, stream *bufio.Reader) sseEvent {
+	t.Helper()
+	var ev sseEvent
+	for {
+		line, err := stream.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			return ev
+		case strings.HasPrefix(line, ":"):
+			ev.comment = strings.TrimSpace(strings.TrimPrefix(line, ":"))
+		case strings.HasPrefix(line, "event: "):
+			ev.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			ev.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func TestSSETransport(t *testing.T) {
+	server := mcp.NewServer(newTestRouter(), slog.New(slog.NewJSONHandler(io.Discard, nil)))
+	transport := mcp.NewSSETransport(server)
+	transport.KeepAlive = 20 * time.Millisecond
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /sse", transport.StreamHandler)
+	mux.HandleFunc("POST /messages", transport.MessageHandler)
+	httpServer := httptest.NewServer(mux)
+	defer httpServer.Close()
+
+	ctx, disconnect := context.WithCancel(context.Background())
+	defer disconnect()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, httpServer.URL+"/sse", nil)
+	require.NoError(t, err)
+	resp, err := httpServer.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	stream := bufio.NewReader(resp.Body)
+
+	endpoint := readEvent(t, stream)
+	require.Equal(t, "endpoint", endpoint.event)
+	require.True(t, strings.HasPrefix(endpoint.data, "/messages?sessionId="))
+	assert.Equal(t, 1, transport.Sessions())
+
+	t.Run("Response Delivered On Stream", func(t *testing.T) {
+		post, err := httpServer.Client().Post(httpServer.URL+endpoint.data, "application/json",
+			strings.NewReader(`{"jsonrpc":"2.0","id":7,"method":"tools/call","params":{"name":"get_issue","arguments":{"issue_key":"PROJ-7"}}}`))
+		require.NoError(t, err)
+		_ = post.Body.Close()
+		assert.Equal(t, http.StatusAccepted, post.StatusCode)
+
+		// Keep-alive comments may arrive before the response
+		ev := readEvent(t, stream)
+		for ev.comment == "keep-alive" {
+			ev = readEvent(t, stream)
+		}
+		assert.Equal(t, "message", ev.event)
+		assert.Contains(t, ev.data, `"id":7`)
+		assert.Contains(t, ev.data, `PROJ-7`)
+	})
+
+	t.Run("Keep-Alive Comments", func(t *testing.T) {
+		assert.Equal(t, "keep-alive", readEvent(t, stream).comment)
+	})
+
+	t.Run("Unknown Session", func(t *testing.T) {
+		post, err := httpServer.Client().Post(httpServer.URL+"/messages?sessionId=nope", "application/json", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"ping"}`))
+		require.NoError(t, err)
+		_ = post.Body.Close()
+		assert.Equal(t, http.StatusNotFound, post.StatusCode)
+	})
+
+	t.Run("Disconnect Closes Session", func(t *testing.T) {
+		disconnect()
+		assert.Eventually(t, func() bool { return transport.Sessions() == 0 }, time.Second, 5*time.Millisecond)
+
+		post, err := httpServer.Client().Post(httpServer.URL+endpoint.data, "application/json", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"ping"}`))
+		require.NoError(t, err)
+		_ = post.Body.Close()
+		assert.Equal(t, http.StatusNotFound, post.StatusCode)
+	})
+}
@@ -0,0 +1,186 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"jira-mcp-server/internal/handlers"
+	"jira-mcp-server/internal/jira"
+)
+
+// Tool is an operation advertised by tools/list and invoked by tools/call.
+// Method and Path name the REST endpoint that performs it.
+type Tool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	InputSchema json.RawMessage `json:"inputSchema"`
+
+	Method string `json:"-"`
+	Path   string `json:"-"`
+
+	// newRequest builds the API request that performs the tool call from its arguments.
+	newRequest func(ctx context.Context, args json.RawMessage) (*http.Request, error)
+}
+
+// toolResult is the result of tools/call. Failures of the operation itself are
+// reported with IsError rather than as JSON-RPC errors, so the model can see them.
+type toolResult struct {
+	Content []textContent `json:"content"`
+	IsError bool          `json:"isError"`
+}
+
+type textContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// getIssueArgs are the arguments of the get_issue tool.
+type getIssueArgs struct {
+	IssueKey string   `json:"issue_key" jsonschema:"required" description:"Issue key, e.g. PROJ-123"`
+	Fields   []string `json:"fields,omitempty" description:"Fields to return; all by default"`
+}
+
+// epicIssuesArgs are the arguments of the get_epic_issues tool.
+type epicIssuesArgs struct {
+	EpicKey string `json:"epic_key" jsonschema:"required" description:"Key of the epic, e.g. PROJ-100"`
+}
+
+// DefaultTools returns the tools backed by the REST API's routes. Input schemas are
+// derived from the structs the arguments are decoded into.
+func DefaultTools() []Tool {
+	return []Tool{
+		{
+			Name:        "create_jira_issue",
+			Description: "Create a JIRA issue. Returns the new issue's id, key and URL.",
+			InputSchema: SchemaFor(jira.CreateIssueRequest{}),
+			Method:      http.MethodPost,
+			Path:        "/create_jira_issue",
+			newRequest: func(ctx context.Context, args json.RawMessage) (*http.Request, error) {
+				return newJSONRequest(ctx, http.MethodPost, "/create_jira_issue", args)
+			},
+		},
+		{
+			Name:        "search_jira_issues",
+			Description: "Search JIRA issues with JQL. Returns a page of matching issues and the total count.",
+			InputSchema: SchemaFor(handlers.SearchRequest{}),
+			Method:      http.MethodPost,
+			Path:        "/search_jira_issues",
+			newRequest: func(ctx context.Context, args json.RawMessage) (*http.Request, error) {
+				return newJSONRequest(ctx, http.MethodPost, "/search_jira_issues", args)
+			},
+		},
+		{
+			Name:        "get_issue",
+			Description: "Get the details of a JIRA issue by key.",
+			InputSchema: SchemaFor(getIssueArgs{}),
+			Method:      http.MethodGet,
+			Path:        "/jira_issue/{issueKey}",
+			newRequest: func(ctx context.Context, args json.RawMessage) (*http.Request, error) {
+				var a getIssueArgs
+				if err := decodeArguments(args, &a); err != nil {
+					return nil, err
+				}
+				if a.IssueKey == "" {
+					return nil, fmt.Errorf("missing required argument issue_key")
+				}
+				path := "/jira_issue/" + url.PathEscape(a.IssueKey)
+				if len(a.Fields) > 0 {
+					path += "?" + url.Values{"fields": {strings.Join(a.Fields, ",")}}.Encode()
+				}
+				return http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+			},
+		},
+		{
+			Name:        "get_epic_issues",
+			Description: "List the issues in a JIRA epic.",
+			InputSchema: SchemaFor(epicIssuesArgs{}),
+			Method:      http.MethodGet,
+			Path:        "/jira_epic/{epicKey}/issues",
+			newRequest: func(ctx context.Context, args json.RawMessage) (*http.Request, error) {
+				var a epicIssuesArgs
+				if err := decodeArguments(args, &a); err != nil {
+					return nil, err
+				}
+				if a.EpicKey == "" {
+					return nil, fmt.Errorf("missing required argument epic_key")
+				}
+				return http.NewRequestWithContext(ctx, http.MethodGet, "/jira_epic/"+url.PathEscape(a.EpicKey)+"/issues", nil)
+			},
+		},
+	}
+}
+
+// callTool performs a tool call through the server's handler and returns the
+// response body as text content. Responses with an error status are flagged IsError.
+func (s *Server) callTool(ctx context.Context, tool *Tool, args json.RawMessage) toolResult {
+	httpReq, err := tool.newRequest(ctx, args)
+	if err != nil {
+		return toolResult{Content: []textContent{{Type: "text", Text: "Invalid arguments: " + err.Error()}}, IsError: true}
+	}
+
+	rw := &toolResponseWriter{header: make(http.Header), status: http.StatusOK}
+	s.handler.ServeHTTP(rw, httpReq)
+	if rw.status >= http.StatusBadRequest {
+		s.logger.WarnContext(ctx, "MCP tool call failed", "tool", tool.Name, "status", rw.status)
+	}
+	return toolResult{
+		Content: []textContent{{Type: "text", Text: strings.TrimSpace(rw.body.String())}},
+		IsError: rw.status >= http.StatusBadRequest,
+	}
+}
+
+// toolResponseWriter collects the response of the API request performing a tool
+// call, which becomes the text of the tool result.
+type toolResponseWriter struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *toolResponseWriter) Header() http.Header { return w.header }
+
+func (w *toolResponseWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.status, w.wroteHeader = code, true
+	}
+}
+
+func (w *toolResponseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.body.Write(b)
+}
+
+// decodeArguments decodes a tool call's arguments into out; missing arguments decode as {}.
+func decodeArguments(args json.RawMessage, out interface{}) error {
+	if len(args) == 0 || string(args) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(args, out); err != nil {
+		return fmt.Errorf("arguments must be a JSON object")
+	}
+	return nil
+}
+
+// newJSONRequest builds a request whose body is the tool call's arguments, which
+// share the shape of the corresponding REST request body.
+func newJSONRequest(ctx context.Context, method, path string, args json.RawMessage) (*http.Request, error) {
+	var object map[string]json.RawMessage
+	if err := decodeArguments(args, &object); err != nil {
+		return nil, err
+	}
+	if object == nil {
+		args = json.RawMessage("{}")
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, method, path, bytes.NewReader(args))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	return httpReq, nil
+}
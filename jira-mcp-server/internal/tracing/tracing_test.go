@@ -0,0 +1,55 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"jira-mcp-server/internal/tracing"
+)
+
+func TestSetup(t *testing.T) {
+	t.Run("Disabled Without Endpoint", func(t *testing.T) {
+		before := otel.GetTracerProvider()
+
+		shutdown, err := tracing.Setup(context.Background(), "")
+
+		require.NoError(t, err)
+		assert.Equal(t, before, otel.GetTracerProvider(), "the global tracer provider should be left alone")
+		assert.NoError(t, shutdown(context.Background()))
+	})
+
+	t.Run("Exports Spans", func(t *testing.T) {
+		var exports atomic.Int32
+		collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/traces", r.URL.Path)
+			exports.Add(1)
+		}))
+		defer collector.Close()
+
+		previous := otel.GetTracerProvider()
+		defer otel.SetTracerProvider(previous)
+
+		shutdown, err := tracing.Setup(context.Background(), collector.URL)
+		require.NoError(t, err)
+
+		_, span := otel.Tracer("test").Start(context.Background(), "test span")
+		span.End()
+		require.NoError(t, shutdown(context.Background()))
+
+		assert.Equal(t, int32(1), exports.Load(), "shutdown should flush the span to the collector")
+	})
+}
+
+func TestSetup_InvalidEndpoint(t *testing.T) {
+	for _, endpoint := range []string{"otel-collector:4318", "ftp://otel-collector", "http://"} {
+		_, err := tracing.Setup(context.Background(), endpoint)
+		assert.ErrorContains(t, err, "invalid OTLP endpoint", "endpoint %q", endpoint)
+	}
+}